@@ -0,0 +1,14 @@
+data "autonomi_attachments" "all" {
+  workspace_id = data.autonomi_workspace.network.id
+}
+
+# fail when a transport of the shared workspace is not attached on both sides
+check "transports_attached" {
+  assert {
+    condition = alltrue([
+      for transport in data.autonomi_transports.deployed.transports :
+      length([for attachment in data.autonomi_attachments.all.attachments : attachment if attachment.transport_id == transport.id]) == 2
+    ])
+    error_message = "Every transport must have two attachments."
+  }
+}
@@ -0,0 +1,61 @@
+data "autonomi_catalog_search" "fr5_to_aws" {
+  physical_access = {
+    filters = [
+      {
+        name     = "location"
+        operator = "="
+        values   = ["Equinix FR5"]
+      },
+    ]
+    sort = [
+      {
+        name  = "priceMrc"
+        value = "asc"
+      },
+    ]
+    limit = 1
+  }
+
+  transport = {
+    filters = [
+      {
+        name     = "location"
+        operator = "="
+        values   = ["Equinix FR5"]
+      },
+      {
+        name     = "locationTo"
+        operator = "="
+        values   = ["TELEHOUSE TH2"]
+      },
+      {
+        name     = "bandwidth"
+        operator = "="
+        values   = ["100"]
+      },
+    ]
+  }
+
+  cloud = {
+    filters = [
+      {
+        name     = "cspName"
+        operator = "="
+        values   = ["AWS"]
+      },
+      {
+        name     = "location"
+        operator = "="
+        values   = ["TELEHOUSE TH2"]
+      },
+    ]
+  }
+}
+
+output "access_sku" {
+  value = data.autonomi_catalog_search.fr5_to_aws.physical_access_hits[0].sku
+}
+
+output "transport_skus" {
+  value = data.autonomi_catalog_search.fr5_to_aws.transport_hits[*].sku
+}
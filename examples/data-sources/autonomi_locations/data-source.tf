@@ -0,0 +1,14 @@
+data "autonomi_locations" "fr5" {
+  names = ["Equinix FR5"]
+}
+
+# What can I build in FR5?
+output "fr5_products" {
+  value = data.autonomi_locations.fr5.locations[0].product_kinds
+}
+
+output "fr5_aws_bandwidths" {
+  value = one([
+    for cloud in data.autonomi_locations.fr5.locations[0].clouds : cloud.bandwidths if cloud.csp_name == "AWS"
+  ])
+}
@@ -0,0 +1,10 @@
+data "autonomi_node" "aws" {
+  workspace_id = data.autonomi_workspace.network.id
+  filters = [
+    {
+      name     = "name"
+      operator = "="
+      values   = ["aws-eu-west-1"]
+    },
+  ]
+}
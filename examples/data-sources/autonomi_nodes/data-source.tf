@@ -0,0 +1,20 @@
+data "autonomi_nodes" "deployed_cloud_nodes" {
+  workspace_id = data.autonomi_workspace.network.id
+  filters = [
+    {
+      name     = "type"
+      operator = "="
+      values   = ["cloud"]
+    },
+    {
+      name     = "administrative_state"
+      operator = "="
+      values   = ["deployed"]
+    },
+    {
+      name     = "cloud_provider"
+      operator = "IN"
+      values   = ["AWS", "Azure"]
+    },
+  ]
+}
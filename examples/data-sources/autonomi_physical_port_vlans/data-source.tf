@@ -0,0 +1,12 @@
+data "autonomi_physical_port_vlans" "fr5" {
+  name = "port-fr5"
+  vlan_range = {
+    from = 100
+    to   = 199
+  }
+  next_count = 2
+}
+
+output "next_vlans" {
+  value = data.autonomi_physical_port_vlans.fr5.next_free_vlans
+}
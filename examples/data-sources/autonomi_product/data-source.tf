@@ -0,0 +1,17 @@
+variable "transport_sku" {
+  type = string
+}
+
+data "autonomi_product" "transport" {
+  sku = var.transport_sku
+}
+
+output "transport_product" {
+  value = {
+    kind      = data.autonomi_product.transport.kind
+    from      = data.autonomi_product.transport.hit.location
+    to        = data.autonomi_product.transport.hit.location_to
+    bandwidth = data.autonomi_product.transport.hit.bandwidth
+    price_mrc = data.autonomi_product.transport.hit.price_mrc
+  }
+}
@@ -0,0 +1,10 @@
+data "autonomi_transports" "deployed" {
+  workspace_id = data.autonomi_workspace.network.id
+  filters = [
+    {
+      name     = "administrative_state"
+      operator = "="
+      values   = ["deployed"]
+    },
+  ]
+}
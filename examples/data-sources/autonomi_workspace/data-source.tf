@@ -0,0 +1,3 @@
+data "autonomi_workspace" "network" {
+  name = "shared-network"
+}
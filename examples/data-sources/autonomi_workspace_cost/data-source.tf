@@ -0,0 +1,11 @@
+data "autonomi_workspace_cost" "network" {
+  workspace_id = data.autonomi_workspace.network.id
+}
+
+output "network_monthly_price" {
+  value = data.autonomi_workspace_cost.network.total.price_mrc
+}
+
+output "network_monthly_price_by_location" {
+  value = { for location, totals in data.autonomi_workspace_cost.network.totals_by_location : location => totals.price_mrc }
+}
@@ -0,0 +1,10 @@
+check "network_workspace" {
+  data "autonomi_workspace_lint" "network" {
+    workspace_id = data.autonomi_workspace.network.id
+  }
+
+  assert {
+    condition     = data.autonomi_workspace_lint.network.error_count == 0
+    error_message = join("\n", [for finding in data.autonomi_workspace_lint.network.findings : finding.message if finding.severity == "error"])
+  }
+}
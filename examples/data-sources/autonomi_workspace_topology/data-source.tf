@@ -0,0 +1,8 @@
+data "autonomi_workspace_topology" "network" {
+  workspace_id = data.autonomi_workspace.network.id
+}
+
+resource "local_file" "network_topology" {
+  filename = "${path.module}/network.dot"
+  content  = data.autonomi_workspace_topology.network.dot
+}
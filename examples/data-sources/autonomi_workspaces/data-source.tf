@@ -0,0 +1,9 @@
+data "autonomi_workspaces" "shared" {
+  filters = [
+    {
+      name     = "description"
+      operator = "="
+      values   = ["shared"]
+    },
+  ]
+}
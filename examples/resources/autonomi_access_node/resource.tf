@@ -6,4 +6,17 @@ resource "autonomi_access_node" "access_node" {
   }
   physical_port_id = var.physical_port_id
   vlan = var.access_vlan
-}
\ No newline at end of file
+}
+# The VLAN is allocated on creation, the lowest one free on the physical port within the range
+resource "autonomi_access_node" "allocated_vlan" {
+  name = "Node name"
+  workspace_id = autonomi_workspace.workspace.id
+  product = {
+    sku = "valid_sku"
+  }
+  physical_port_id = var.physical_port_id
+  allowed_vlan_range = {
+    from = 100
+    to   = 199
+  }
+}
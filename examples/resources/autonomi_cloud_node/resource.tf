@@ -1,10 +1,10 @@
 resource "autonomi_cloud_node" "cloud_node" {
   name = "Node name"
   workspace_id = autonomi_workspace.workspace.id
-  provider_config = {
-    aws_account_id = "aws_account_id"
-  }
   product = {
     sku = "valid_sku"
   }
-}
\ No newline at end of file
+  aws {
+    account_id = "123456789012"
+  }
+}
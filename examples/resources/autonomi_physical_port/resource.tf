@@ -3,4 +3,5 @@ resource "autonomi_physical_port" "physical_port" {
   product = {
     sku = "valid_sku"
   }
+  deletion_protection = true
 }
\ No newline at end of file
@@ -1,9 +1,6 @@
 package models
 
-import (
-	autonomisdk "github.com/intercloud/autonomi-sdk"
-	"github.com/meilisearch/meilisearch-go"
-)
+import "github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 
 type ProviderType string
 
@@ -32,7 +29,7 @@ type Product struct {
 	SKU              string `json:"sku"`
 }
 
-type Clients struct {
-	CatalogClient  *meilisearch.Client
-	AutonomiClient *autonomisdk.Client
-}
+// Clients are the clients the provider shares with its resources and data sources.
+//
+// Deprecated: Use providerdata.Clients instead.
+type Clients = providerdata.Clients
@@ -0,0 +1,152 @@
+// Package budget keeps track of the monthly cost of the workspaces while Terraform plans their elements,
+// so plans pushing a workspace over its budget can be refused.
+package budget
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Element is an element of a workspace, with its monthly cost.
+type Element struct {
+	// Key identifies the element in the ledger, its ID once created. Elements planned for creation have
+	// no ID yet, the ledger keying them itself when registered without key.
+	Key string
+	// Name of the element in diagnostics, e.g. `transport "paris-frankfurt"`
+	Name        string
+	SKU         string
+	MonthlyCost int64
+}
+
+// Ledger records the budget of the workspaces and their elements during a Terraform run, the elements
+// existing in a workspace being seeded from the API before the planned ones are registered.
+// It is shared by the resources through the provider data, each resource planning its element
+// concurrently with the others.
+type Ledger struct {
+	mu            sync.Mutex
+	defaultBudget *int64
+	budgets       map[string]int64
+	elements      map[string]map[string]Element
+	seeded        map[string]bool
+	planned       int
+}
+
+// NewLedger returns an empty ledger, `defaultBudget` applying to the workspaces without budget when set.
+func NewLedger(defaultBudget *int64) *Ledger {
+	return &Ledger{
+		defaultBudget: defaultBudget,
+		budgets:       map[string]int64{},
+		elements:      map[string]map[string]Element{},
+		seeded:        map[string]bool{},
+	}
+}
+
+// SetBudget sets the maximum monthly cost of the workspace, the default budget applying when nil.
+func (l *Ledger) SetBudget(workspaceID string, maxMonthlyCost *int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if maxMonthlyCost == nil {
+		delete(l.budgets, workspaceID)
+		return
+	}
+	l.budgets[workspaceID] = *maxMonthlyCost
+}
+
+// Budget returns the maximum monthly cost of the workspace, false being returned when it has none.
+func (l *Ledger) Budget(workspaceID string) (int64, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.budget(workspaceID)
+}
+
+func (l *Ledger) budget(workspaceID string) (int64, bool) {
+	if maxMonthlyCost, ok := l.budgets[workspaceID]; ok {
+		return maxMonthlyCost, true
+	}
+	if l.defaultBudget != nil {
+		return *l.defaultBudget, true
+	}
+	return 0, false
+}
+
+// Seed records the elements existing in the workspace, as listed by `list`, the first time it is called
+// for the workspace. The workspace is seeded again on the next call when listing its elements fails.
+func (l *Ledger) Seed(workspaceID string, list func() ([]Element, error)) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.seeded[workspaceID] {
+		return nil
+	}
+	elements, err := list()
+	if err != nil {
+		return err
+	}
+
+	l.seeded[workspaceID] = true
+	if l.elements[workspaceID] == nil {
+		l.elements[workspaceID] = map[string]Element{}
+	}
+	for _, element := range elements {
+		// elements registered before the seeding were planned against their current state
+		if _, ok := l.elements[workspaceID][element.Key]; !ok {
+			l.elements[workspaceID][element.Key] = element
+		}
+	}
+	return nil
+}
+
+// Register records the planned element of the workspace, replacing the element previously recorded
+// under the same key. Elements without key are planned creations, each of them being recorded apart.
+func (l *Ledger) Register(workspaceID string, element Element) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element.Key == "" {
+		l.planned++
+		element.Key = fmt.Sprintf("planned-%d", l.planned)
+	}
+	if l.elements[workspaceID] == nil {
+		l.elements[workspaceID] = map[string]Element{}
+	}
+	l.elements[workspaceID][element.Key] = element
+}
+
+// Remove forgets the element of the workspace recorded under the key, e.g. when planned for destruction.
+func (l *Ledger) Remove(workspaceID string, key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.elements[workspaceID], key)
+}
+
+// Exceeded returns the monthly cost of the workspace and its elements sorted by name, when the
+// registered elements cost more than the budget of the workspace.
+func (l *Ledger) Exceeded(workspaceID string) (total int64, elements []Element, exceeded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	maxMonthlyCost, ok := l.budget(workspaceID)
+	if !ok {
+		return 0, nil, false
+	}
+
+	for _, element := range l.elements[workspaceID] {
+		total += element.MonthlyCost
+		elements = append(elements, element)
+	}
+	if total <= maxMonthlyCost {
+		return total, nil, false
+	}
+
+	sort.Slice(elements, func(i, j int) bool {
+		if elements[i].Name == elements[j].Name {
+			return elements[i].Key < elements[j].Key
+		}
+		return elements[i].Name < elements[j].Name
+	})
+	return total, elements, true
+}
@@ -0,0 +1,145 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedgerExceeded(t *testing.T) {
+	defaultBudget := int64(500)
+	workspaceBudget := int64(200)
+
+	tests := []struct {
+		name          string
+		defaultBudget *int64
+		budget        *int64
+		elements      []Element
+		total         int64
+		expect        []Element
+	}{
+		{
+			name: "workspace without budget",
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 1000},
+			},
+		},
+		{
+			name:   "within the workspace budget",
+			budget: &workspaceBudget,
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+				{Name: `cloud node "a"`, SKU: "AWS", MonthlyCost: 50},
+			},
+			total: 200,
+		},
+		{
+			name:   "over the workspace budget",
+			budget: &workspaceBudget,
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+				{Name: `cloud node "a"`, SKU: "AWS", MonthlyCost: 100},
+			},
+			total: 250,
+			expect: []Element{
+				{Key: "planned-2", Name: `cloud node "a"`, SKU: "AWS", MonthlyCost: 100},
+				{Key: "planned-1", Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+			},
+		},
+		{
+			name:          "over the default budget",
+			defaultBudget: &defaultBudget,
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 600},
+			},
+			total: 600,
+			expect: []Element{
+				{Key: "planned-1", Name: `transport "b"`, SKU: "TRP", MonthlyCost: 600},
+			},
+		},
+		{
+			name:          "workspace budget prevailing over the default one",
+			defaultBudget: &workspaceBudget,
+			budget:        &defaultBudget,
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 300},
+			},
+			total: 300,
+		},
+		{
+			name:   "replanned element counted once",
+			budget: &workspaceBudget,
+			elements: []Element{
+				{Key: "b", Name: `transport "b"`, SKU: "TRP-1G", MonthlyCost: 300},
+				{Key: "b", Name: `transport "b"`, SKU: "TRP-100M", MonthlyCost: 150},
+			},
+			total: 150,
+		},
+		{
+			name:   "planned elements sharing their name counted apart",
+			budget: &workspaceBudget,
+			elements: []Element{
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+				{Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+			},
+			total: 300,
+			expect: []Element{
+				{Key: "planned-1", Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+				{Key: "planned-2", Name: `transport "b"`, SKU: "TRP", MonthlyCost: 150},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		ledger := NewLedger(tc.defaultBudget)
+		ledger.SetBudget("workspace", tc.budget)
+		for _, element := range tc.elements {
+			ledger.Register("workspace", element)
+		}
+
+		total, elements, exceeded := ledger.Exceeded("workspace")
+		assert.Equal(t, tc.expect != nil, exceeded)
+		assert.Equal(t, tc.expect, elements)
+		if exceeded {
+			assert.Equal(t, tc.total, total)
+		}
+	}
+}
+
+func TestLedgerSeed(t *testing.T) {
+	workspaceBudget := int64(500)
+	ledger := NewLedger(&workspaceBudget)
+	existing := []Element{
+		{Key: "a", Name: `cloud node "a"`, SKU: "AWS", MonthlyCost: 100},
+		{Key: "b", Name: `transport "b"`, SKU: "TRP-100M", MonthlyCost: 150},
+		{Key: "c", Name: `transport "c"`, SKU: "TRP-100M", MonthlyCost: 150},
+	}
+
+	// listing errors are not remembered
+	err := ledger.Seed("workspace", func() ([]Element, error) { return nil, assert.AnError })
+	assert.Equal(t, assert.AnError, err)
+
+	// elements registered before the seeding prevail
+	ledger.Register("workspace", Element{Key: "b", Name: `transport "b"`, SKU: "TRP-1G", MonthlyCost: 300})
+	assert.NoError(t, ledger.Seed("workspace", func() ([]Element, error) { return existing, nil }))
+	assert.NoError(t, ledger.Seed("workspace", func() ([]Element, error) {
+		t.Error("workspace seeded twice")
+		return nil, nil
+	}))
+
+	total, elements, exceeded := ledger.Exceeded("workspace")
+	assert.True(t, exceeded)
+	assert.Equal(t, int64(550), total)
+	assert.Equal(t, []Element{
+		{Key: "a", Name: `cloud node "a"`, SKU: "AWS", MonthlyCost: 100},
+		{Key: "b", Name: `transport "b"`, SKU: "TRP-1G", MonthlyCost: 300},
+		{Key: "c", Name: `transport "c"`, SKU: "TRP-100M", MonthlyCost: 150},
+	}, elements)
+
+	// destroyed elements are not counted anymore
+	ledger.Remove("workspace", "c")
+	_, _, exceeded = ledger.Exceeded("workspace")
+	assert.False(t, exceeded)
+}
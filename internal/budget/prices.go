@@ -0,0 +1,71 @@
+package budget
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/intercloud/terraform-provider-autonomi/internal/catalog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// productIndexes are the catalog indexes listing the products of the elements.
+var productIndexes = []string{"cloudproduct", "accessproduct", "transportproduct", "portproduct"}
+
+// Prices looks the monthly recurring price of the products up in the catalog, caching them for the
+// duration of the Terraform run so the elements sharing a product query the catalog once.
+type Prices struct {
+	client *meilisearch.Client
+
+	mu     sync.Mutex
+	prices map[string]*int64
+}
+
+// NewPrices returns a price lookup reading the given catalog.
+func NewPrices(client *meilisearch.Client) *Prices {
+	return &Prices{
+		client: client,
+		prices: map[string]*int64{},
+	}
+}
+
+// MonthlyPrice returns the monthly recurring price of the product, false being returned when the
+// catalog does not list its SKU. Catalog errors are not cached.
+func (p *Prices) MonthlyPrice(sku string) (int64, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if price, ok := p.prices[sku]; ok {
+		if price == nil {
+			return 0, false, nil
+		}
+		return *price, true, nil
+	}
+
+	lookups := make([]catalog.SKULookup, 0, len(productIndexes))
+	for _, index := range productIndexes {
+		lookups = append(lookups, catalog.SKULookup{Index: index, SKU: sku})
+	}
+	hits, err := catalog.LookupSKUs(p.client, lookups, 1)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, indexHits := range hits {
+		if len(indexHits) == 0 {
+			continue
+		}
+
+		var product struct {
+			PriceMRC int64 `json:"priceMrc"`
+		}
+		if err := json.Unmarshal(indexHits[0], &product); err != nil {
+			return 0, false, err
+		}
+
+		p.prices[sku] = &product.PriceMRC
+		return product.PriceMRC, true, nil
+	}
+
+	p.prices[sku] = nil
+	return 0, false, nil
+}
@@ -0,0 +1,60 @@
+package budget
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricesMonthlyPrice(t *testing.T) {
+	searches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches++
+
+		var request struct {
+			Queries []struct {
+				IndexUID string   `json:"indexUid"`
+				Filter   []string `json:"filter"`
+			} `json:"queries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+
+		results := []map[string]any{}
+		for _, query := range request.Queries {
+			hits := []map[string]any{}
+			if query.IndexUID == "transportproduct" && query.Filter[0] == `sku = "TRP-PAR-FRA-100"` {
+				hits = append(hits, map[string]any{"sku": "TRP-PAR-FRA-100", "priceMrc": 150})
+			}
+			results = append(results, map[string]any{"indexUid": query.IndexUID, "hits": hits})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	defer server.Close()
+
+	prices := NewPrices(meilisearch.NewClient(meilisearch.ClientConfig{Host: server.URL}))
+
+	price, found, err := prices.MonthlyPrice("TRP-PAR-FRA-100")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(150), price)
+	assert.Equal(t, 1, searches)
+
+	_, found, err = prices.MonthlyPrice("UNKNOWN")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, 2, searches)
+
+	// both products are cached
+	price, found, _ = prices.MonthlyPrice("TRP-PAR-FRA-100")
+	assert.True(t, found)
+	assert.Equal(t, int64(150), price)
+	_, found, _ = prices.MonthlyPrice("UNKNOWN")
+	assert.False(t, found)
+	assert.Equal(t, 2, searches)
+}
@@ -0,0 +1,83 @@
+// Package catalog looks the products of the Autonomi catalog up by SKU.
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// SKULookup is the lookup of the products having a SKU in an index of the catalog.
+type SKULookup struct {
+	// Index is the name of the Meilisearch index.
+	Index string
+	// Filters the products must match in the index, besides having the SKU.
+	Filters []string
+	// SKU of the products.
+	SKU string
+}
+
+// request builds the search request of the lookup, returning at most `limit` products.
+func (l SKULookup) request(limit int64) *meilisearch.SearchRequest {
+	return &meilisearch.SearchRequest{
+		IndexUID: l.Index,
+		Filter:   append(append([]string{}, l.Filters...), fmt.Sprintf("sku = %q", l.SKU)),
+		Limit:    limit,
+	}
+}
+
+// LookupSKUs runs the lookups in a single request to the catalog, and returns the products found by
+// each of them, in the order of the lookups and left encoded. At most `limit` products are returned by lookup.
+func LookupSKUs(client *meilisearch.Client, lookups []SKULookup, limit int64) ([][]json.RawMessage, error) {
+	if len(lookups) == 0 {
+		return nil, nil
+	}
+
+	multiSearch := &meilisearch.MultiSearchRequest{}
+	for _, lookup := range lookups {
+		multiSearch.Queries = append(multiSearch.Queries, lookup.request(limit))
+	}
+	respProducts, err := client.MultiSearch(multiSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	respJSON, err := json.Marshal(respProducts)
+	if err != nil {
+		return nil, err
+	}
+	var results struct {
+		Results []struct {
+			Hits []json.RawMessage `json:"hits"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(respJSON, &results); err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(lookups) {
+		return nil, fmt.Errorf("the catalog returned %d results for %d queries", len(results.Results), len(lookups))
+	}
+
+	products := make([][]json.RawMessage, 0, len(lookups))
+	for _, result := range results.Results {
+		products = append(products, result.Hits)
+	}
+	return products, nil
+}
+
+// LookupSKU decodes the first product found by the lookup into `product`, false being returned when the
+// catalog does not list it. The product is not decoded when `product` is nil.
+func LookupSKU(client *meilisearch.Client, lookup SKULookup, product any) (bool, error) {
+	products, err := LookupSKUs(client, []SKULookup{lookup}, 1)
+	if err != nil {
+		return false, err
+	}
+	if len(products[0]) == 0 {
+		return false, nil
+	}
+	if product == nil {
+		return true, nil
+	}
+	return true, json.Unmarshal(products[0][0], product)
+}
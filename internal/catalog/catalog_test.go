@@ -0,0 +1,86 @@
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupSKUs(t *testing.T) {
+	indexes := map[string][]map[string]any{
+		"cloudproduct": {
+			{"sku": "AWS-PAR-100", "cspName": "AWS"},
+		},
+		"accessproduct": {
+			{"sku": "ICL-PAR-1G", "type": "PHYSICAL"},
+			{"sku": "ICL-PAR-1G", "type": "VIRTUAL"},
+		},
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/multi-search", r.URL.Path)
+
+		var request struct {
+			Queries []struct {
+				IndexUID string   `json:"indexUid"`
+				Filter   []string `json:"filter"`
+				Limit    int      `json:"limit"`
+			} `json:"queries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+
+		results := []map[string]any{}
+		for _, query := range request.Queries {
+			hits := []map[string]any{}
+			for _, product := range indexes[query.IndexUID] {
+				filter := query.Filter[len(query.Filter)-1]
+				if len(hits) < query.Limit && filter == `sku = "`+product["sku"].(string)+`"` {
+					hits = append(hits, product)
+				}
+			}
+			results = append(results, map[string]any{"indexUid": query.IndexUID, "hits": hits})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	defer server.Close()
+	client := meilisearch.NewClient(meilisearch.ClientConfig{Host: server.URL})
+
+	// every lookup is answered in a single request, in the order of the lookups
+	products, err := LookupSKUs(client, []SKULookup{
+		{Index: "accessproduct", SKU: "ICL-PAR-1G"},
+		{Index: "cloudproduct", SKU: "ICL-PAR-1G"},
+		{Index: "cloudproduct", SKU: "AWS-PAR-100"},
+	}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Len(t, products, 3)
+	assert.Len(t, products[0], 2)
+	assert.Len(t, products[1], 0)
+	assert.Len(t, products[2], 1)
+
+	var cloudProduct struct {
+		CSPName string `json:"cspName"`
+	}
+	found, err := LookupSKU(client, SKULookup{Index: "cloudproduct", SKU: "AWS-PAR-100"}, &cloudProduct)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "AWS", cloudProduct.CSPName)
+
+	found, err = LookupSKU(client, SKULookup{Index: "cloudproduct", SKU: "AWS-PAR-200"}, nil)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// no request is made without lookups
+	products, err = LookupSKUs(client, nil, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, products)
+	assert.Equal(t, 3, requests)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
 	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 	"github.com/meilisearch/meilisearch-go"
 )
 
@@ -115,7 +116,7 @@ func (d *accessProductDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	clients, ok := req.ProviderData.(models.Clients)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
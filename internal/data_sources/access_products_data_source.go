@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
 	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 	"github.com/meilisearch/meilisearch-go"
 )
 
@@ -148,7 +149,7 @@ func (d *accessProductsDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	clients, ok := req.ProviderData.(models.Clients)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
@@ -0,0 +1,193 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+// attachmentFilters are the fields the attachments can be filtered on.
+var attachmentFilters = []string{"id", "node_id", "transport_id", "side", "administrative_state"}
+
+type attachmentsDataSource struct {
+	client *autonomisdk.Client
+}
+
+type attachmentHit struct {
+	ID          types.String      `tfsdk:"id"`
+	WorkspaceID types.String      `tfsdk:"workspace_id"`
+	CreatedAt   timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt   timetypes.RFC3339 `tfsdk:"updated_at"`
+	NodeID      types.String      `tfsdk:"node_id"`
+	TransportID types.String      `tfsdk:"transport_id"`
+	Side        types.String      `tfsdk:"side"`
+	State       types.String      `tfsdk:"administrative_state"`
+}
+
+type attachmentsDataSourceModel struct {
+	WorkspaceID types.String     `tfsdk:"workspace_id"`
+	Filters     []filters.Filter `tfsdk:"filters"`
+	Attachments []attachmentHit  `tfsdk:"attachments"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &attachmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &attachmentsDataSource{}
+)
+
+func NewAttachmentsDataSource() datasource.DataSource {
+	return &attachmentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *attachmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachments"
+}
+
+// Schema defines the schema for the data source.
+func (d *attachmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Datasource to retrieve the list of the attachments of a workspace by filters.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"filters":      filters.AutonomiAttribute(attachmentFilters...),
+			"attachments": schema.ListNestedAttribute{
+				MarkdownDescription: "The **attachments** of the workspace matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The **ID** of the attachment.",
+							Computed:            true,
+						},
+						"workspace_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the workspace to which the attachment belongs.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Creation date of the attachment",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Update date of the attachment",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the node attached to the transport",
+							Computed:            true,
+						},
+						"transport_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the transport attached to the node",
+							Computed:            true,
+						},
+						"side": schema.StringAttribute{
+							MarkdownDescription: "Side of the transport the node is attached to [A, Z]",
+							Computed:            true,
+						},
+						"administrative_state": schema.StringAttribute{
+							MarkdownDescription: `Administrative state of the attachment [creation_pending, creation_proceed, creation_error,
+deployed, delete_pending, delete_proceed, delete_error]`,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func attachmentHitFromAPI(attachment autonomisdkmodel.Attachment) attachmentHit {
+	return attachmentHit{
+		ID:          types.StringValue(attachment.ID.String()),
+		WorkspaceID: types.StringValue(attachment.WorkspaceID.String()),
+		CreatedAt:   timestampValue(attachment.CreatedAt),
+		UpdatedAt:   timestampValue(attachment.UpdatedAt),
+		NodeID:      types.StringValue(attachment.NodeID),
+		TransportID: types.StringValue(attachment.TransportID),
+		Side:        stringValue(attachment.Side),
+		State:       types.StringValue(attachment.State.String()),
+	}
+}
+
+// attachmentField returns the value of the field of the attachment filtered by the filters.
+func attachmentField(attachment *autonomisdkmodel.Attachment, name string) (string, bool) {
+	switch name {
+	case "id":
+		return attachment.ID.String(), true
+	case "node_id":
+		return attachment.NodeID, true
+	case "transport_id":
+		return attachment.TransportID, true
+	case "side":
+		return attachment.Side, true
+	case "administrative_state":
+		return attachment.State.String(), true
+	}
+	return "", false
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *attachmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *attachmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data attachmentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachments, err := d.client.ListAttachments(ctx, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Attachments",
+			"Could not list the attachments of workspace "+data.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	filteredAttachments, err := filters.ApplyFields(*attachments, data.Filters, attachmentField)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting filters", err.Error())
+		return
+	}
+
+	data.Attachments = []attachmentHit{}
+	for _, attachment := range filteredAttachments {
+		data.Attachments = append(data.Attachments, attachmentHitFromAPI(attachment))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,186 @@
+package datasources
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// catalogSearchQuery is a sub-query of a catalog search, on the products of a kind.
+type catalogSearchQuery struct {
+	Filters []filters.Filter    `tfsdk:"filters"`
+	Sort    []filters.SortFacet `tfsdk:"sort"`
+	Limit   types.Int64         `tfsdk:"limit"`
+}
+
+// catalogSearchKind describes where the products of a kind are listed in the catalog.
+type catalogSearchKind struct {
+	// attribute of the data source holding the sub-query, and prefixing the attribute of its hits
+	attribute string
+	// name of the Meilisearch index
+	index string
+	// filters the products of the kind match in the index
+	filters []string
+}
+
+var (
+	cloudSearchKind = catalogSearchKind{
+		attribute: "cloud",
+		index:     "cloudproduct",
+	}
+	transportSearchKind = catalogSearchKind{
+		attribute: "transport",
+		index:     "transportproduct",
+	}
+	physicalAccessSearchKind = catalogSearchKind{
+		attribute: "physical_access",
+		index:     "accessproduct",
+		filters: []string{
+			fmt.Sprintf("provider = %q", models.INTERCLOUD),
+			fmt.Sprintf("type = %q", models.PHYSICAL),
+		},
+	}
+	virtualAccessSearchKind = catalogSearchKind{
+		attribute: "virtual_access",
+		index:     "accessproduct",
+		filters: []string{
+			fmt.Sprintf("type = %q", models.VIRTUAL),
+		},
+	}
+	physicalPortSearchKind = catalogSearchKind{
+		attribute: "physical_port",
+		index:     "portproduct",
+	}
+)
+
+// catalogSearch is a sub-query of a catalog search with the kind of products it queries.
+type catalogSearch struct {
+	kind  catalogSearchKind
+	query *catalogSearchQuery
+}
+
+// request builds the search request of the sub-query, restricted to the products of its kind.
+func (s catalogSearch) request() (*meilisearch.SearchRequest, error) {
+	filterStrings, err := filters.GetFiltersString(s.query.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("%s filters: %w", s.kind.attribute, err)
+	}
+
+	return &meilisearch.SearchRequest{
+		IndexUID: s.kind.index,
+		Filter:   append(append([]string{}, s.kind.filters...), filterStrings...),
+		Sort:     filters.GetSortString(s.query.Sort),
+		Limit:    s.query.Limit.ValueInt64(),
+	}, nil
+}
+
+// catalogSearchResults holds the hits of the sub-queries of a multi-search, in the order of the queries,
+// left encoded until the kind of their products is known.
+type catalogSearchResults struct {
+	Results []struct {
+		Hits json.RawMessage `json:"hits"`
+	} `json:"results"`
+}
+
+// decodeCatalogSearch decodes the hits of the sub-queries of a multi-search, in a single pass over the response.
+func decodeCatalogSearch(resp *meilisearch.MultiSearchResponse, searches int) (catalogSearchResults, error) {
+	var results catalogSearchResults
+	respJSON, err := json.Marshal(resp)
+	if err != nil {
+		return results, err
+	}
+	if err := json.Unmarshal(respJSON, &results); err != nil {
+		return results, err
+	}
+	if len(results.Results) != searches {
+		return results, fmt.Errorf("the catalog returned %d results for %d queries", len(results.Results), searches)
+	}
+	return results, nil
+}
+
+func cloudHitFromCatalog(cp models.CloudProduct) cloudHits {
+	return cloudHits{
+		ID:        types.Int64Value(int64(cp.ID)),
+		Provider:  types.StringValue(cp.Provider),
+		Duration:  types.Int64Value(int64(cp.Duration)),
+		Location:  types.StringValue(cp.Location),
+		Bandwidth: types.Int64Value(int64(cp.Bandwidth)),
+		Date:      types.StringValue(cp.Date),
+		PriceNRC:  types.Float64Value(float64(cp.PriceNRC)),
+		PriceMRC:  types.Float64Value(float64(cp.PriceMRC)),
+		CostNRC:   types.Float64Value(float64(cp.CostNRC)),
+		CostMRC:   types.Float64Value(float64(cp.CostMRC)),
+		SKU:       types.StringValue(cp.SKU),
+		CSPName:   types.StringValue(cp.CSPName),
+	}
+}
+
+func transportHitFromCatalog(tp models.TransportProduct) transportHits {
+	return transportHits{
+		ID:                 types.Int64Value(int64(tp.ID)),
+		Provider:           types.StringValue(tp.Provider),
+		Duration:           types.Int64Value(int64(tp.Duration)),
+		Location:           types.StringValue(tp.Location),
+		LocationUnderlay:   types.StringValue(tp.LocationUnderlay),
+		Bandwidth:          types.Int64Value(int64(tp.Bandwidth)),
+		Date:               types.StringValue(tp.Date),
+		PriceNRC:           types.Float64Value(float64(tp.PriceNRC)),
+		PriceMRC:           types.Float64Value(float64(tp.PriceMRC)),
+		CostNRC:            types.Float64Value(float64(tp.CostNRC)),
+		CostMRC:            types.Float64Value(float64(tp.CostMRC)),
+		SKU:                types.StringValue(tp.SKU),
+		LocationTo:         types.StringValue(tp.LocationTo),
+		LocationToUnderlay: types.StringValue(tp.LocationToUnderlay),
+	}
+}
+
+func accessHitFromCatalog(ap models.AccessProduct) accessHits {
+	return accessHits{
+		ID:        types.Int64Value(int64(ap.ID)),
+		Provider:  types.StringValue(ap.Provider),
+		Duration:  types.Int64Value(int64(ap.Duration)),
+		Location:  types.StringValue(ap.Location),
+		Bandwidth: types.Int64Value(int64(ap.Bandwidth)),
+		Date:      types.StringValue(ap.Date),
+		PriceNRC:  types.Float64Value(float64(ap.PriceNRC)),
+		PriceMRC:  types.Float64Value(float64(ap.PriceMRC)),
+		CostNRC:   types.Float64Value(float64(ap.CostNRC)),
+		CostMRC:   types.Float64Value(float64(ap.CostMRC)),
+		SKU:       types.StringValue(ap.SKU),
+		Type:      types.StringValue(ap.Type),
+	}
+}
+
+func physicalPortProductHitFromCatalog(pp models.PhysicalPortProduct) physicalPortProductHits {
+	return physicalPortProductHits{
+		ID:        types.Int64Value(int64(pp.ID)),
+		Provider:  types.StringValue(pp.Provider),
+		Duration:  types.Int64Value(int64(pp.Duration)),
+		Location:  types.StringValue(pp.Location),
+		Bandwidth: types.Int64Value(int64(pp.Bandwidth)),
+		Date:      types.StringValue(pp.Date),
+		PriceNRC:  types.Float64Value(float64(pp.PriceNRC)),
+		PriceMRC:  types.Float64Value(float64(pp.PriceMRC)),
+		CostNRC:   types.Float64Value(float64(pp.CostNRC)),
+		CostMRC:   types.Float64Value(float64(pp.CostMRC)),
+		SKU:       types.StringValue(pp.SKU),
+	}
+}
+
+// decodeHits decodes the hits of a sub-query into products of type P, mapped to their Terraform model H.
+func decodeHits[P, H any](hitsJSON json.RawMessage, hit func(P) H) ([]H, error) {
+	var products []P
+	if err := json.Unmarshal(hitsJSON, &products); err != nil {
+		return nil, err
+	}
+
+	hits := make([]H, 0, len(products))
+	for _, product := range products {
+		hits = append(hits, hit(product))
+	}
+	return hits, nil
+}
@@ -0,0 +1,271 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+type catalogSearchDataSource struct {
+	client *meilisearch.Client
+}
+
+type catalogSearchDataSourceModel struct {
+	Cloud              *catalogSearchQuery       `tfsdk:"cloud"`
+	Transport          *catalogSearchQuery       `tfsdk:"transport"`
+	PhysicalAccess     *catalogSearchQuery       `tfsdk:"physical_access"`
+	VirtualAccess      *catalogSearchQuery       `tfsdk:"virtual_access"`
+	PhysicalPort       *catalogSearchQuery       `tfsdk:"physical_port"`
+	CloudHits          []cloudHits               `tfsdk:"cloud_hits"`
+	TransportHits      []transportHits           `tfsdk:"transport_hits"`
+	PhysicalAccessHits []accessHits              `tfsdk:"physical_access_hits"`
+	VirtualAccessHits  []accessHits              `tfsdk:"virtual_access_hits"`
+	PhysicalPortHits   []physicalPortProductHits `tfsdk:"physical_port_hits"`
+}
+
+// searches returns the sub-queries set in the configuration, in the order of the attributes.
+func (m *catalogSearchDataSourceModel) searches() []catalogSearch {
+	var searches []catalogSearch
+	for _, s := range []catalogSearch{
+		{kind: cloudSearchKind, query: m.Cloud},
+		{kind: transportSearchKind, query: m.Transport},
+		{kind: physicalAccessSearchKind, query: m.PhysicalAccess},
+		{kind: virtualAccessSearchKind, query: m.VirtualAccess},
+		{kind: physicalPortSearchKind, query: m.PhysicalPort},
+	} {
+		if s.query != nil {
+			searches = append(searches, s)
+		}
+	}
+	return searches
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &catalogSearchDataSource{}
+	_ datasource.DataSourceWithConfigure = &catalogSearchDataSource{}
+)
+
+func NewCatalogSearchDataSource() datasource.DataSource {
+	return &catalogSearchDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *catalogSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_search"
+}
+
+// catalogSearchQueryAttribute returns the schema of a sub-query on the products of a kind.
+func catalogSearchQueryAttribute(kind string, filterNames, sortNames []string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Query on the %s products. Their hits are only returned when set.", kind),
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.ListNestedAttribute{
+				MarkdownDescription: "List of filters: [" + strings.Join(filterNames, ", ") + "]",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the filter",
+							Optional:            true,
+						},
+						"operator": schema.StringAttribute{
+							MarkdownDescription: "Comparison operators. You can use the following list: **=**, **!=**, **>**, **>=**, **<**, **<=**, **IN**, **TO**. **IN** will return any products which have the values you passed when **TO** will return any value contained between the two (and only two) values you passed.",
+							Optional:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "Values of the filter",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"sort": schema.ListNestedAttribute{
+				MarkdownDescription: "List of sort: [" + strings.Join(sortNames, ", ") + "]",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the key used for sorting",
+							Optional:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "You can sort list ascending using **asc** or descending using **desc**. The order of the values matters as the first entry will be prioritized",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of hits returned. Defaults to 20.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// catalogSearchHitsAttribute returns the schema of the hits of a sub-query, the attributes common to every
+// product completed with the ones specific to its kind.
+func catalogSearchHitsAttribute(kind string, specific map[string]schema.Attribute) schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"id":        schema.Int64Attribute{Computed: true},
+		"provider":  schema.StringAttribute{Computed: true},
+		"duration":  schema.Int64Attribute{Computed: true},
+		"location":  schema.StringAttribute{Computed: true},
+		"bandwidth": schema.Int64Attribute{Computed: true},
+		"date":      schema.StringAttribute{Computed: true},
+		"price_nrc": schema.Float64Attribute{Computed: true},
+		"price_mrc": schema.Float64Attribute{Computed: true},
+		"cost_nrc":  schema.Float64Attribute{Computed: true},
+		"cost_mrc":  schema.Float64Attribute{Computed: true},
+		"sku":       schema.StringAttribute{Computed: true},
+	}
+	for name, attribute := range specific {
+		attributes[name] = attribute
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s products matching the **%s** query.", kind, kind),
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *catalogSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to search several kinds of products in a single request to the catalog.
+Each query has its own filters and sort, and its hits are returned in the attribute of their kind.`,
+		Attributes: map[string]schema.Attribute{
+			"cloud": catalogSearchQueryAttribute("cloud",
+				[]string{"cspName", "cspRegion", "cspCity", "location", "bandwidth", "provider"},
+				[]string{"location", "bandwidth", "priceNrc", "priceMrc"}),
+			"transport": catalogSearchQueryAttribute("transport",
+				[]string{"location", "locationTo", "bandwidth", "provider"},
+				[]string{"location", "locationTo", "bandwidth", "priceNrc", "priceMrc"}),
+			"physical_access": catalogSearchQueryAttribute("physical access",
+				[]string{"location", "bandwidth", "provider"},
+				[]string{"location", "bandwidth", "priceNrc", "priceMrc"}),
+			"virtual_access": catalogSearchQueryAttribute("virtual access",
+				[]string{"location", "bandwidth", "provider"},
+				[]string{"location", "bandwidth", "priceNrc", "priceMrc"}),
+			"physical_port": catalogSearchQueryAttribute("physical port",
+				[]string{"location", "bandwidth", "provider", "duration"},
+				[]string{"location", "bandwidth", "priceNrc", "priceMrc"}),
+			"cloud_hits": catalogSearchHitsAttribute("cloud", map[string]schema.Attribute{
+				"csp_name": schema.StringAttribute{Computed: true},
+			}),
+			"transport_hits": catalogSearchHitsAttribute("transport", map[string]schema.Attribute{
+				"location_underlay":    schema.StringAttribute{Computed: true},
+				"location_to":          schema.StringAttribute{Computed: true},
+				"location_to_underlay": schema.StringAttribute{Computed: true},
+			}),
+			"physical_access_hits": catalogSearchHitsAttribute("physical_access", map[string]schema.Attribute{
+				"type": schema.StringAttribute{Computed: true},
+			}),
+			"virtual_access_hits": catalogSearchHitsAttribute("virtual_access", map[string]schema.Attribute{
+				"type": schema.StringAttribute{Computed: true},
+			}),
+			"physical_port_hits": catalogSearchHitsAttribute("physical_port", nil),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *catalogSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.CatalogClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *catalogSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data catalogSearchDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searches := data.searches()
+	if len(searches) == 0 {
+		resp.Diagnostics.AddError("No catalog query", "Set at least one of cloud, transport, physical_access, virtual_access and physical_port.")
+		return
+	}
+
+	// Run every sub-query in a single request to the catalog
+	multiSearch := &meilisearch.MultiSearchRequest{}
+	for _, s := range searches {
+		searchRequest, err := s.request()
+		if err != nil {
+			resp.Diagnostics.AddError("error getting filters", err.Error())
+			return
+		}
+		multiSearch.Queries = append(multiSearch.Queries, searchRequest)
+	}
+
+	respProducts, err := d.client.MultiSearch(multiSearch)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Search the Autonomi Catalog", err.Error())
+		return
+	}
+
+	results, err := decodeCatalogSearch(respProducts, len(searches))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Search the Autonomi Catalog", err.Error())
+		return
+	}
+
+	for i, s := range searches {
+		hitsJSON := results.Results[i].Hits
+		switch s.kind.attribute {
+		case cloudSearchKind.attribute:
+			data.CloudHits, err = decodeHits(hitsJSON, cloudHitFromCatalog)
+		case transportSearchKind.attribute:
+			data.TransportHits, err = decodeHits(hitsJSON, transportHitFromCatalog)
+		case physicalAccessSearchKind.attribute:
+			data.PhysicalAccessHits, err = decodeHits(hitsJSON, accessHitFromCatalog)
+		case virtualAccessSearchKind.attribute:
+			data.VirtualAccessHits, err = decodeHits(hitsJSON, accessHitFromCatalog)
+		case physicalPortSearchKind.attribute:
+			data.PhysicalPortHits, err = decodeHits(hitsJSON, physicalPortProductHitFromCatalog)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to Read the %s Hits", s.kind.attribute), err.Error())
+			return
+		}
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
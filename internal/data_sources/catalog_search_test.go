@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogSearchRequest(t *testing.T) {
+	search := catalogSearch{
+		kind: physicalAccessSearchKind,
+		query: &catalogSearchQuery{
+			Filters: []filters.Filter{{
+				Name:     types.StringValue("location"),
+				Operator: types.StringValue("="),
+				Values:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Equinix FR5")}),
+			}},
+			Sort:  []filters.SortFacet{{Name: types.StringValue("priceMrc"), Value: types.StringValue("asc")}},
+			Limit: types.Int64Value(5),
+		},
+	}
+
+	request, err := search.request()
+	assert.NoError(t, err)
+	assert.Equal(t, &meilisearch.SearchRequest{
+		IndexUID: "accessproduct",
+		Filter:   []string{`provider = "InterCloud"`, `type = "PHYSICAL"`, `location = "Equinix FR5"`},
+		Sort:     []string{"priceMrc:asc"},
+		Limit:    5,
+	}, request)
+	// the filters of the kind are not altered by the sub-query
+	assert.Len(t, physicalAccessSearchKind.filters, 2)
+}
+
+func TestDecodeCatalogSearch(t *testing.T) {
+	resp := &meilisearch.MultiSearchResponse{
+		Results: []meilisearch.SearchResponse{
+			{IndexUID: "cloudproduct", Hits: []interface{}{map[string]interface{}{"sku": "cloud", "cspName": "AWS", "priceMrc": 100}}},
+			{IndexUID: "accessproduct", Hits: []interface{}{}},
+		},
+	}
+
+	results, err := decodeCatalogSearch(resp, 2)
+	assert.NoError(t, err)
+
+	hits, err := decodeHits(results.Results[0].Hits, cloudHitFromCatalog)
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+	assert.Equal(t, types.StringValue("cloud"), hits[0].SKU)
+	assert.Equal(t, types.StringValue("AWS"), hits[0].CSPName)
+	assert.Equal(t, types.Float64Value(100), hits[0].PriceMRC)
+
+	accessHits, err := decodeHits(results.Results[1].Hits, accessHitFromCatalog)
+	assert.NoError(t, err)
+	assert.Empty(t, accessHits)
+
+	_, err = decodeCatalogSearch(resp, 3)
+	assert.Error(t, err)
+
+	_, err = decodeHits(json.RawMessage(`{}`), cloudHitFromCatalog)
+	assert.Error(t, err)
+}
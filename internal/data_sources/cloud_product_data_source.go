@@ -8,6 +8,7 @@ import (
 
 	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
 	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -120,7 +121,7 @@ func (d *cloudProductDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	clients, ok := req.ProviderData.(models.Clients)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
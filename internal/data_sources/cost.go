@@ -0,0 +1,122 @@
+package datasources
+
+import (
+	"sort"
+
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+)
+
+const (
+	costSourceCatalog = "catalog"
+	costSourceAPI     = "api"
+)
+
+// costLine is the cost of an element of a workspace.
+type costLine struct {
+	ElementID   string
+	ElementKind string
+	ElementType string
+	Name        string
+	SKU         string
+	Location    string
+	Duration    int64
+	PriceMRC    int64
+	PriceNRC    int64
+	CostMRC     int64
+	CostNRC     int64
+	// Source tells whether the prices come from the catalog, or from the API when the catalog does not
+	// list the product anymore
+	Source string
+}
+
+// costTotals sums the prices and the costs of elements.
+type costTotals struct {
+	PriceMRC int64
+	PriceNRC int64
+	CostMRC  int64
+	CostNRC  int64
+}
+
+func (t *costTotals) add(line costLine) {
+	t.PriceMRC += line.PriceMRC
+	t.PriceNRC += line.PriceNRC
+	t.CostMRC += line.CostMRC
+	t.CostNRC += line.CostNRC
+}
+
+// costReport is the cost of the elements of a workspace.
+type costReport struct {
+	Lines      []costLine
+	Total      costTotals
+	ByType     map[string]costTotals
+	ByLocation map[string]costTotals
+}
+
+// newCostReport prices the nodes and the transports of a workspace from their catalog products, by SKU.
+// The lines are sorted by kind, type and name.
+func newCostReport(nodes []autonomisdkmodel.Node, transports []autonomisdkmodel.Transport, catalogProducts map[string]models.Product) costReport {
+	report := costReport{
+		Lines:      make([]costLine, 0, len(nodes)+len(transports)),
+		ByType:     map[string]costTotals{},
+		ByLocation: map[string]costTotals{},
+	}
+
+	line := func(id, kind, elementType, name string, product autonomisdkmodel.Product) costLine {
+		l := costLine{
+			ElementID:   id,
+			ElementKind: kind,
+			ElementType: elementType,
+			Name:        name,
+			SKU:         product.SKU,
+			Location:    product.Location,
+			Duration:    int64(product.Duration),
+			PriceMRC:    int64(product.PriceMRC),
+			PriceNRC:    int64(product.PriceNRC),
+			CostMRC:     int64(product.CostMRC),
+			CostNRC:     int64(product.CostNRC),
+			Source:      costSourceAPI,
+		}
+		if catalogProduct, ok := catalogProducts[product.SKU]; ok {
+			l.Location = catalogProduct.Location
+			l.Duration = int64(catalogProduct.Duration)
+			l.PriceMRC = int64(catalogProduct.PriceMRC)
+			l.PriceNRC = int64(catalogProduct.PriceNRC)
+			l.CostMRC = int64(catalogProduct.CostMRC)
+			l.CostNRC = int64(catalogProduct.CostNRC)
+			l.Source = costSourceCatalog
+		}
+		return l
+	}
+	for _, node := range nodes {
+		report.Lines = append(report.Lines, line(node.ID.String(), vertexKindNode, node.Type.String(), node.Name, node.Product))
+	}
+	for _, transport := range transports {
+		report.Lines = append(report.Lines, line(transport.ID.String(), vertexKindTransport, vertexKindTransport, transport.Name, transport.Product))
+	}
+
+	sort.Slice(report.Lines, func(i, j int) bool {
+		a, b := report.Lines[i], report.Lines[j]
+		if a.ElementKind != b.ElementKind {
+			return a.ElementKind < b.ElementKind
+		}
+		if a.ElementType != b.ElementType {
+			return a.ElementType < b.ElementType
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ElementID < b.ElementID
+	})
+
+	for _, l := range report.Lines {
+		report.Total.add(l)
+		byType := report.ByType[l.ElementType]
+		byType.add(l)
+		report.ByType[l.ElementType] = byType
+		byLocation := report.ByLocation[l.Location]
+		byLocation.add(l)
+		report.ByLocation[l.Location] = byLocation
+	}
+	return report
+}
@@ -0,0 +1,64 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostReport(t *testing.T) {
+	nodeAWS := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	nodeDC := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	transport := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	report := newCostReport(
+		[]autonomisdkmodel.Node{
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: nodeDC},
+				Name:      "dc",
+				Type:      autonomisdkmodel.NodeTypeAccess,
+				// withdrawn from the catalog, priced from the API
+				Product: autonomisdkmodel.Product{SKU: "ACCESS-LD5", Location: "LD5", Duration: 12, PriceMRC: 300, PriceNRC: 50, CostMRC: 200, CostNRC: 40},
+			},
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: nodeAWS},
+				Name:      "aws",
+				Type:      autonomisdkmodel.NodeTypeCloud,
+				Product:   autonomisdkmodel.Product{SKU: "CLOUD-FR5", PriceMRC: 1},
+			},
+		},
+		[]autonomisdkmodel.Transport{
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: transport},
+				Name:      "fr5-ld5",
+				Product:   autonomisdkmodel.Product{SKU: "TRANSPORT-FR5-LD5"},
+			},
+		},
+		map[string]models.Product{
+			"CLOUD-FR5":         {SKU: "CLOUD-FR5", Location: "FR5", Duration: 1, PriceMRC: 100, PriceNRC: 0, CostMRC: 60, CostNRC: 0},
+			"TRANSPORT-FR5-LD5": {SKU: "TRANSPORT-FR5-LD5", Location: "FR5", Duration: 24, PriceMRC: 500, PriceNRC: 100, CostMRC: 350, CostNRC: 80},
+		},
+	)
+
+	assert.Equal(t, []costLine{
+		{ElementID: nodeDC.String(), ElementKind: "node", ElementType: "access", Name: "dc", SKU: "ACCESS-LD5", Location: "LD5",
+			Duration: 12, PriceMRC: 300, PriceNRC: 50, CostMRC: 200, CostNRC: 40, Source: "api"},
+		{ElementID: nodeAWS.String(), ElementKind: "node", ElementType: "cloud", Name: "aws", SKU: "CLOUD-FR5", Location: "FR5",
+			Duration: 1, PriceMRC: 100, CostMRC: 60, Source: "catalog"},
+		{ElementID: transport.String(), ElementKind: "transport", ElementType: "transport", Name: "fr5-ld5", SKU: "TRANSPORT-FR5-LD5", Location: "FR5",
+			Duration: 24, PriceMRC: 500, PriceNRC: 100, CostMRC: 350, CostNRC: 80, Source: "catalog"},
+	}, report.Lines)
+	assert.Equal(t, costTotals{PriceMRC: 900, PriceNRC: 150, CostMRC: 610, CostNRC: 120}, report.Total)
+	assert.Equal(t, map[string]costTotals{
+		"access":    {PriceMRC: 300, PriceNRC: 50, CostMRC: 200, CostNRC: 40},
+		"cloud":     {PriceMRC: 100, CostMRC: 60},
+		"transport": {PriceMRC: 500, PriceNRC: 100, CostMRC: 350, CostNRC: 80},
+	}, report.ByType)
+	assert.Equal(t, map[string]costTotals{
+		"FR5": {PriceMRC: 600, PriceNRC: 100, CostMRC: 410, CostNRC: 80},
+		"LD5": {PriceMRC: 300, PriceNRC: 50, CostMRC: 200, CostNRC: 40},
+	}, report.ByLocation)
+}
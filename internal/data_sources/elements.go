@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+)
+
+// elementProduct is the product of a workspace element.
+type elementProduct struct {
+	SKU       types.String `tfsdk:"sku"`
+	Provider  types.String `tfsdk:"provider"`
+	Location  types.String `tfsdk:"location"`
+	Bandwidth types.Int64  `tfsdk:"bandwidth"`
+	Duration  types.Int64  `tfsdk:"duration"`
+	PriceMRC  types.Int64  `tfsdk:"price_mrc"`
+	PriceNRC  types.Int64  `tfsdk:"price_nrc"`
+}
+
+// workspaceIDAttribute returns the attribute of the workspace the elements are listed from.
+func workspaceIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the workspace the elements are listed from.",
+		Required:            true,
+	}
+}
+
+// elementProductAttribute returns the attribute of the product of a workspace element.
+func elementProductAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The **product** of the element.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"sku": schema.StringAttribute{
+				MarkdownDescription: "The **SKU** of the product.",
+				Computed:            true,
+			},
+			"provider": schema.StringAttribute{
+				MarkdownDescription: "The **provider** of the product.",
+				Computed:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "The **location** of the product.",
+				Computed:            true,
+			},
+			"bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "The **bandwidth** of the product, in Mbps.",
+				Computed:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The commitment **duration** of the product, in months.",
+				Computed:            true,
+			},
+			"price_mrc": schema.Int64Attribute{
+				MarkdownDescription: "The **monthly recurring price** (MRC) of the product.",
+				Computed:            true,
+			},
+			"price_nrc": schema.Int64Attribute{
+				MarkdownDescription: "The **non-recurring price** (NRC) of the product.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// stringValue converts an API string into a value, an empty string being converted to null.
+func stringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func elementProductFromAPI(product autonomisdkmodel.Product) elementProduct {
+	return elementProduct{
+		SKU:       types.StringValue(product.SKU),
+		Provider:  types.StringValue(product.Provider.String()),
+		Location:  types.StringValue(product.Location),
+		Bandwidth: types.Int64Value(int64(product.Bandwidth)),
+		Duration:  types.Int64Value(int64(product.Duration)),
+		PriceMRC:  types.Int64Value(int64(product.PriceMRC)),
+		PriceNRC:  types.Int64Value(int64(product.PriceNRC)),
+	}
+}
+
+// workspaceElements are the nodes, the transports and the attachments of a workspace.
+type workspaceElements struct {
+	nodes       []autonomisdkmodel.Node
+	transports  []autonomisdkmodel.Transport
+	attachments []autonomisdkmodel.Attachment
+}
+
+// listWorkspaceElements lists the nodes, the transports and the attachments of the workspace.
+func listWorkspaceElements(ctx context.Context, client *autonomisdk.Client, workspaceID string) (workspaceElements, error) {
+	nodes, err := client.ListNodes(ctx, workspaceID)
+	if err != nil {
+		return workspaceElements{}, fmt.Errorf("could not list the nodes of workspace %s: %w", workspaceID, err)
+	}
+	transports, err := client.ListTransports(ctx, workspaceID)
+	if err != nil {
+		return workspaceElements{}, fmt.Errorf("could not list the transports of workspace %s: %w", workspaceID, err)
+	}
+	attachments, err := client.ListAttachments(ctx, workspaceID)
+	if err != nil {
+		return workspaceElements{}, fmt.Errorf("could not list the attachments of workspace %s: %w", workspaceID, err)
+	}
+
+	return workspaceElements{
+		nodes:       *nodes,
+		transports:  *transports,
+		attachments: *attachments,
+	}, nil
+}
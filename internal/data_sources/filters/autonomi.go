@@ -1,9 +1,12 @@
 package filters
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
 )
 
@@ -53,43 +56,87 @@ func validateFilterAutonomi(filter Filter) error {
 	}
 }
 
+// AutonomiAttribute returns the schema of the filters applied with ApplyFields, on the given fields.
+func AutonomiAttribute(fields ...string) schema.ListNestedAttribute {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, "**"+field+"**")
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: fmt.Sprintf(`List of filters: [%s].
+Operators available are **=** and **IN**`, strings.Join(fields, ", ")),
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the filter among " + strings.Join(names, ", "),
+					Optional:            true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: "Comparison operators. You can use the following list: **=**, **IN**. **IN** will return any elements which have the values you passed.",
+					Optional:            true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "Values of the filter",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
 func Apply(physicalPorts []autonomisdkmodel.PhysicalPort, filtersTerraform []Filter) ([]autonomisdkmodel.PhysicalPort, error) {
-	var result []autonomisdkmodel.PhysicalPort
+	return ApplyFields(physicalPorts, filtersTerraform, physicalPortField)
+}
+
+// ApplyFields returns the elements matching every filter, `field` returning the value of the field of an
+// element named by the lowercased filter name, and false when the elements have no such field. Filters on
+// unknown fields are rejected.
+func ApplyFields[T any](elements []T, filtersTerraform []Filter, field func(element *T, name string) (string, bool)) ([]T, error) {
+	var result []T
 
 	filters, err := filtersAutonomiFromTerraform(filtersTerraform)
 	if err != nil {
 		return nil, err
 	}
+	var zero T
+	for _, filter := range filters {
+		if _, ok := field(&zero, filter.Name); !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownName, filter.Name)
+		}
+	}
 
 mainloop:
-	for _, physicalPort := range physicalPorts {
+	for i := range elements {
 		for _, filter := range filters {
-			if !filter.match(&physicalPort) {
+			value, _ := field(&elements[i], filter.Name)
+			if !matchesFilter(value, filter.Operator, filter.Values) {
 				continue mainloop
 			}
 		}
-		result = append(result, physicalPort)
+		result = append(result, elements[i])
 	}
 	return result, nil
 }
 
-func (fg *filterAutonomi) match(physicalPort *autonomisdkmodel.PhysicalPort) bool {
-	if fg.Name == "id" {
-		return matchesFilter(physicalPort.ID.String(), fg.Operator, fg.Values)
-	}
-	if fg.Name == "name" {
-		return matchesFilter(physicalPort.Name, fg.Operator, fg.Values)
-	}
-	if fg.Name == "location" {
-		return matchesFilter(physicalPort.Product.Location, fg.Operator, fg.Values)
-	}
-	if fg.Name == "pricemrc" {
-		return matchesFilter(strconv.Itoa(physicalPort.Product.PriceMRC), fg.Operator, fg.Values)
-	}
-	if fg.Name == "pricenrc" {
-		return matchesFilter(strconv.Itoa(physicalPort.Product.PriceNRC), fg.Operator, fg.Values)
+func physicalPortField(physicalPort *autonomisdkmodel.PhysicalPort, name string) (string, bool) {
+	switch name {
+	case "id":
+		return physicalPort.ID.String(), true
+	case "name":
+		return physicalPort.Name, true
+	case "location":
+		return physicalPort.Product.Location, true
+	case "bandwidth":
+		return strconv.Itoa(physicalPort.Product.Bandwidth), true
+	case "pricemrc":
+		return strconv.Itoa(physicalPort.Product.PriceMRC), true
+	case "pricenrc":
+		return strconv.Itoa(physicalPort.Product.PriceNRC), true
 	}
-	return true
+	return "", false
 }
 
 func matchesFilter(physicalPortValue string, operator FilterType, filterFieldValue []string) bool {
@@ -32,6 +32,7 @@ var (
 	)
 	ErrOnlyOneValue  = errors.New("errors values: must contain one value")
 	ErrOnlyTwoValues = errors.New("errors values: must contain two values")
+	ErrUnknownName   = errors.New("unknown filter name")
 )
 
 type Filter struct {
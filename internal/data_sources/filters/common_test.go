@@ -1,6 +1,7 @@
 package filters
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -205,3 +206,93 @@ func TestGetFiltersString(t *testing.T) {
 		assert.Equal(t, tc.expect, filterString)
 	}
 }
+
+func TestApplyFields(t *testing.T) {
+	type element struct {
+		name        string
+		description string
+	}
+	elements := []element{
+		{name: "network", description: "shared"},
+		{name: "app", description: "shared"},
+		{name: "lab", description: "sandbox"},
+	}
+	field := func(e *element, name string) (string, bool) {
+		switch name {
+		case "name":
+			return e.name, true
+		case "description":
+			return e.description, true
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		name    string
+		filters []Filter
+		expect  []element
+		err     error
+	}{
+		{
+			name:   "no filter",
+			expect: elements,
+		},
+		{
+			name: EqualFilterType.String(),
+			filters: []Filter{
+				{
+					Name:     types.StringValue("Description"),
+					Operator: types.StringValue(EqualFilterType.String()),
+					Values:   getValues([]string{"shared"}),
+				},
+			},
+			expect: elements[:2],
+		},
+		{
+			name: InFilterType.String() + " combined with " + EqualFilterType.String(),
+			filters: []Filter{
+				{
+					Name:     types.StringValue("name"),
+					Operator: types.StringValue(InFilterType.String()),
+					Values:   getValues([]string{"app", "lab"}),
+				},
+				{
+					Name:     types.StringValue("description"),
+					Operator: types.StringValue(EqualFilterType.String()),
+					Values:   getValues([]string{"shared"}),
+				},
+			},
+			expect: elements[1:2],
+		},
+		{
+			name: "unknown field",
+			filters: []Filter{
+				{
+					Name:     types.StringValue("location"),
+					Operator: types.StringValue(EqualFilterType.String()),
+					Values:   getValues([]string{"FR5"}),
+				},
+			},
+			err: fmt.Errorf("%w: %q", ErrUnknownName, "location"),
+		},
+		{
+			name: "wrong operator",
+			filters: []Filter{
+				{
+					Name:     types.StringValue("name"),
+					Operator: types.StringValue(AboveFilterType.String()),
+					Values:   getValues([]string{"app"}),
+				},
+			},
+			err: ErrWrongOperator,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		result, err := ApplyFields(elements, tc.filters, field)
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.expect, result)
+	}
+}
@@ -0,0 +1,128 @@
+package datasources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+// lintFinding is an issue found in a workspace.
+type lintFinding struct {
+	Severity    string
+	Rule        string
+	ElementID   string
+	ElementKind string
+	ElementName string
+	Message     string
+}
+
+// transportEnds are the locations of the ends of a transport product.
+type transportEnds struct {
+	location   string
+	locationTo string
+}
+
+// lintWorkspace returns the issues found in the elements of a workspace, sorted by severity, rule and
+// element. `portLocations` gives the location of the physical ports by ID, and `transportProducts` the
+// ends of the transports by ID, the checks needing them being skipped for the elements they lack.
+func lintWorkspace(elements workspaceElements, portLocations map[string]string, transportProducts map[string]transportEnds) []lintFinding {
+	findings := []lintFinding{}
+	add := func(severity, rule, id, kind, name, message string, args ...any) {
+		findings = append(findings, lintFinding{
+			Severity:    severity,
+			Rule:        rule,
+			ElementID:   id,
+			ElementKind: kind,
+			ElementName: name,
+			Message:     fmt.Sprintf(message, args...),
+		})
+	}
+
+	nodes := map[string]autonomisdkmodel.Node{}
+	nodeAttachments := map[string]int{}
+	transportAttachments := map[string]int{}
+	for _, node := range elements.nodes {
+		nodes[node.ID.String()] = node
+	}
+	for _, attachment := range elements.attachments {
+		nodeAttachments[attachment.NodeID]++
+		transportAttachments[attachment.TransportID]++
+	}
+
+	for _, node := range elements.nodes {
+		id := node.ID.String()
+		lintState(add, node.State, id, vertexKindNode, node.Name)
+		if nodeAttachments[id] == 0 {
+			add(lintSeverityWarning, "unattached_node", id, vertexKindNode, node.Name,
+				"Node %q is not attached to any transport.", node.Name)
+		}
+		if node.Type != autonomisdkmodel.NodeTypeAccess {
+			continue
+		}
+		if location, ok := portLocations[node.PhysicalPort.ID.String()]; ok && location != node.Product.Location {
+			add(lintSeverityError, "access_node_port_location", id, vertexKindNode, node.Name,
+				"Access node %q has a product located in %s, while its physical port %s is in %s.",
+				node.Name, node.Product.Location, node.PhysicalPort.ID, location)
+		}
+	}
+
+	for _, transport := range elements.transports {
+		id := transport.ID.String()
+		lintState(add, transport.State, id, vertexKindTransport, transport.Name)
+		switch transportAttachments[id] {
+		case 0:
+			add(lintSeverityWarning, "unattached_transport", id, vertexKindTransport, transport.Name,
+				"Transport %q is not attached to any node.", transport.Name)
+		case 1:
+			add(lintSeverityWarning, "half_attached_transport", id, vertexKindTransport, transport.Name,
+				"Transport %q is attached to a node on one side only.", transport.Name)
+		}
+	}
+
+	for _, attachment := range elements.attachments {
+		id := attachment.ID.String()
+		lintState(add, attachment.State, id, "attachment", "")
+		node, nodeFound := nodes[attachment.NodeID]
+		ends, transportFound := transportProducts[attachment.TransportID]
+		if nodeFound && transportFound && node.Product.Location != ends.location && node.Product.Location != ends.locationTo {
+			add(lintSeverityError, "attachment_location", id, "attachment", "",
+				"Attachment %s links node %q, located in %s, to transport %s running from %s to %s.",
+				id, node.Name, node.Product.Location, attachment.TransportID, ends.location, ends.locationTo)
+		}
+	}
+
+	severities := map[string]int{lintSeverityError: 0, lintSeverityWarning: 1}
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Severity != b.Severity {
+			return severities[a.Severity] < severities[b.Severity]
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.ElementID < b.ElementID
+	})
+	return findings
+}
+
+// lintState reports the elements whose creation or deletion is pending, or failed.
+func lintState(add func(severity, rule, id, kind, name, message string, args ...any), state autonomisdkmodel.AdministrativeState, id, kind, name string) {
+	element := kind + " " + id
+	if name != "" {
+		element = fmt.Sprintf("%s %q", kind, name)
+	}
+
+	switch {
+	case strings.HasSuffix(state.String(), "_error"):
+		add(lintSeverityError, "error_state", id, kind, name, "The %s is in the %s state.", element, state)
+	case strings.HasSuffix(state.String(), "_pending"):
+		add(lintSeverityWarning, "pending_state", id, kind, name, "The %s is in the %s state.", element, state)
+	}
+}
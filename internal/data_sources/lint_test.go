@@ -0,0 +1,129 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintWorkspace(t *testing.T) {
+	port := uuid.MustParse("00000000-0000-0000-0000-0000000000aa")
+	node := func(id, name string, nodeType autonomisdkmodel.NodeType, location string) autonomisdkmodel.Node {
+		return autonomisdkmodel.Node{
+			BaseModel:    autonomisdkmodel.BaseModel{ID: uuid.MustParse(id)},
+			Name:         name,
+			Type:         nodeType,
+			State:        autonomisdkmodel.AdministrativeStateDeployed,
+			Product:      autonomisdkmodel.Product{Location: location},
+			PhysicalPort: autonomisdkmodel.PhysicalPort{BaseModel: autonomisdkmodel.BaseModel{ID: port}},
+		}
+	}
+	transport := func(id, name string) autonomisdkmodel.Transport {
+		return autonomisdkmodel.Transport{
+			BaseModel: autonomisdkmodel.BaseModel{ID: uuid.MustParse(id)},
+			Name:      name,
+			State:     autonomisdkmodel.AdministrativeStateDeployed,
+		}
+	}
+	attachment := func(id, nodeID, transportID string) autonomisdkmodel.Attachment {
+		return autonomisdkmodel.Attachment{
+			BaseModel:   autonomisdkmodel.BaseModel{ID: uuid.MustParse(id)},
+			NodeID:      nodeID,
+			TransportID: transportID,
+			State:       autonomisdkmodel.AdministrativeStateDeployed,
+		}
+	}
+
+	const (
+		nodeFR5      = "00000000-0000-0000-0000-000000000001"
+		nodeLD5      = "00000000-0000-0000-0000-000000000002"
+		nodeAM2      = "00000000-0000-0000-0000-000000000003"
+		transportA   = "00000000-0000-0000-0000-000000000011"
+		transportB   = "00000000-0000-0000-0000-000000000012"
+		transportC   = "00000000-0000-0000-0000-000000000013"
+		attachment1  = "00000000-0000-0000-0000-000000000021"
+		attachment2  = "00000000-0000-0000-0000-000000000022"
+		attachment3  = "00000000-0000-0000-0000-000000000023"
+		portLocation = "FR5"
+	)
+	transportProducts := map[string]transportEnds{
+		transportA: {location: "FR5", locationTo: "LD5"},
+		transportB: {location: "FR5", locationTo: "LD5"},
+	}
+
+	tests := []struct {
+		name     string
+		elements workspaceElements
+		expect   []lintFinding
+	}{
+		{
+			name: "connected workspace",
+			elements: workspaceElements{
+				nodes:       []autonomisdkmodel.Node{node(nodeFR5, "fr5", autonomisdkmodel.NodeTypeAccess, "FR5"), node(nodeLD5, "ld5", autonomisdkmodel.NodeTypeCloud, "LD5")},
+				transports:  []autonomisdkmodel.Transport{transport(transportA, "fr5-ld5")},
+				attachments: []autonomisdkmodel.Attachment{attachment(attachment1, nodeFR5, transportA), attachment(attachment2, nodeLD5, transportA)},
+			},
+			expect: []lintFinding{},
+		},
+		{
+			name: "dangling elements",
+			elements: workspaceElements{
+				nodes:       []autonomisdkmodel.Node{node(nodeFR5, "fr5", autonomisdkmodel.NodeTypeCloud, "FR5"), node(nodeLD5, "ld5", autonomisdkmodel.NodeTypeCloud, "LD5")},
+				transports:  []autonomisdkmodel.Transport{transport(transportA, "fr5-ld5"), transport(transportB, "spare")},
+				attachments: []autonomisdkmodel.Attachment{attachment(attachment1, nodeFR5, transportA)},
+			},
+			expect: []lintFinding{
+				{Severity: "warning", Rule: "half_attached_transport", ElementID: transportA, ElementKind: "transport", ElementName: "fr5-ld5",
+					Message: `Transport "fr5-ld5" is attached to a node on one side only.`},
+				{Severity: "warning", Rule: "unattached_node", ElementID: nodeLD5, ElementKind: "node", ElementName: "ld5",
+					Message: `Node "ld5" is not attached to any transport.`},
+				{Severity: "warning", Rule: "unattached_transport", ElementID: transportB, ElementKind: "transport", ElementName: "spare",
+					Message: `Transport "spare" is not attached to any node.`},
+			},
+		},
+		{
+			name: "misplaced elements",
+			elements: workspaceElements{
+				nodes:       []autonomisdkmodel.Node{node(nodeFR5, "fr5", autonomisdkmodel.NodeTypeCloud, "FR5"), node(nodeAM2, "am2", autonomisdkmodel.NodeTypeAccess, "AM2")},
+				transports:  []autonomisdkmodel.Transport{transport(transportA, "fr5-ld5"), transport(transportC, "withdrawn")},
+				attachments: []autonomisdkmodel.Attachment{attachment(attachment1, nodeFR5, transportA), attachment(attachment2, nodeAM2, transportA), attachment(attachment3, nodeAM2, transportC)},
+			},
+			expect: []lintFinding{
+				{Severity: "error", Rule: "access_node_port_location", ElementID: nodeAM2, ElementKind: "node", ElementName: "am2",
+					Message: `Access node "am2" has a product located in AM2, while its physical port 00000000-0000-0000-0000-0000000000aa is in FR5.`},
+				{Severity: "error", Rule: "attachment_location", ElementID: attachment2, ElementKind: "attachment",
+					Message: `Attachment 00000000-0000-0000-0000-000000000022 links node "am2", located in AM2, to transport 00000000-0000-0000-0000-000000000011 running from FR5 to LD5.`},
+				{Severity: "warning", Rule: "half_attached_transport", ElementID: transportC, ElementKind: "transport", ElementName: "withdrawn",
+					Message: `Transport "withdrawn" is attached to a node on one side only.`},
+			},
+		},
+		{
+			name: "stuck elements",
+			elements: func() workspaceElements {
+				failed := node(nodeFR5, "fr5", autonomisdkmodel.NodeTypeCloud, "FR5")
+				failed.State = autonomisdkmodel.AdministrativeStateCreationError
+				pending := attachment(attachment1, nodeFR5, transportA)
+				pending.State = autonomisdkmodel.AdministrativeStateDeletePending
+				return workspaceElements{
+					nodes:       []autonomisdkmodel.Node{failed, node(nodeLD5, "ld5", autonomisdkmodel.NodeTypeCloud, "LD5")},
+					transports:  []autonomisdkmodel.Transport{transport(transportA, "fr5-ld5")},
+					attachments: []autonomisdkmodel.Attachment{pending, attachment(attachment2, nodeLD5, transportA)},
+				}
+			}(),
+			expect: []lintFinding{
+				{Severity: "error", Rule: "error_state", ElementID: nodeFR5, ElementKind: "node", ElementName: "fr5",
+					Message: `The node "fr5" is in the creation_error state.`},
+				{Severity: "warning", Rule: "pending_state", ElementID: attachment1, ElementKind: "attachment",
+					Message: "The attachment 00000000-0000-0000-0000-000000000021 is in the delete_pending state."},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, lintWorkspace(tc.elements, map[string]string{port.String(): portLocation}, transportProducts))
+	}
+}
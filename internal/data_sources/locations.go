@@ -0,0 +1,277 @@
+package datasources
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// locationKind describes how the products of a kind are reported by location.
+type locationKind struct {
+	kind catalogSearchKind
+	// facets holding the locations of the products
+	locationFacets []string
+	// facet the products are reported by at a location, empty when they are reported together
+	byFacet string
+	// facets describing the products at a location
+	productFacets []string
+}
+
+var locationKinds = []locationKind{
+	{
+		kind:           cloudSearchKind,
+		locationFacets: []string{"location"},
+		byFacet:        "cspName",
+		productFacets:  []string{"bandwidth", "cspRegion", "cspCity", "locationUnderlay"},
+	},
+	{
+		kind:           transportSearchKind,
+		locationFacets: []string{"location", "locationTo"},
+		productFacets:  []string{"bandwidth"},
+	},
+	{
+		kind:           physicalAccessSearchKind,
+		locationFacets: []string{"location"},
+		productFacets:  []string{"bandwidth", "locationUnderlay"},
+	},
+	{
+		kind:           virtualAccessSearchKind,
+		locationFacets: []string{"location"},
+		byFacet:        "provider",
+		productFacets:  []string{"bandwidth", "locationUnderlay"},
+	},
+	{
+		kind:           physicalPortSearchKind,
+		locationFacets: []string{"location"},
+		productFacets:  []string{"bandwidth", "locationUnderlay"},
+	},
+}
+
+// Only the facets of the searches are used, but a single hit is returned as a zero limit is not sent to the catalog.
+const locationSearchLimit = 1
+
+// locationsRequest builds the search request of the locations of the products of the kind.
+func (k locationKind) locationsRequest() *meilisearch.SearchRequest {
+	facets := append([]string{}, k.locationFacets...)
+	if k.byFacet != "" {
+		facets = append(facets, k.byFacet)
+	}
+
+	return &meilisearch.SearchRequest{
+		IndexUID: k.kind.index,
+		Filter:   k.kind.filters,
+		Facets:   facets,
+		Limit:    locationSearchLimit,
+	}
+}
+
+// locationSearch is a search of the products of a kind at a location, restricted to the products having the
+// value of the `byFacet` facet of the kind.
+type locationSearch struct {
+	kind     locationKind
+	location string
+	value    string
+}
+
+// request builds the search request of the products at the location.
+func (s locationSearch) request() *meilisearch.SearchRequest {
+	locationFilters := make([]string, 0, len(s.kind.locationFacets))
+	for _, facet := range s.kind.locationFacets {
+		locationFilters = append(locationFilters, fmt.Sprintf("%s = %q", facet, s.location))
+	}
+	filter := append(append([]string{}, s.kind.kind.filters...), strings.Join(locationFilters, " OR "))
+	if s.kind.byFacet != "" {
+		filter = append(filter, fmt.Sprintf("%s = %q", s.kind.byFacet, s.value))
+	}
+
+	return &meilisearch.SearchRequest{
+		IndexUID: s.kind.kind.index,
+		Filter:   filter,
+		Facets:   s.kind.productFacets,
+		Limit:    locationSearchLimit,
+	}
+}
+
+// facetDistribution is the number of products by facet and value.
+type facetDistribution map[string]map[string]int64
+
+// decodeFacetDistributions decodes the facet distributions of the sub-queries of a multi-search, in the
+// order of the queries.
+func decodeFacetDistributions(resp *meilisearch.MultiSearchResponse, searches int) ([]facetDistribution, error) {
+	var results struct {
+		Results []struct {
+			FacetDistribution facetDistribution `json:"facetDistribution"`
+		} `json:"results"`
+	}
+	respJSON, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(respJSON, &results); err != nil {
+		return nil, err
+	}
+	if len(results.Results) != searches {
+		return nil, fmt.Errorf("the catalog returned %d results for %d queries", len(results.Results), searches)
+	}
+
+	distributions := make([]facetDistribution, 0, searches)
+	for _, result := range results.Results {
+		distributions = append(distributions, result.FacetDistribution)
+	}
+	return distributions, nil
+}
+
+// values returns the values of the facet, sorted.
+func (d facetDistribution) values(facet string) []string {
+	values := make([]string, 0, len(d[facet]))
+	for value, count := range d[facet] {
+		if count > 0 {
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// bandwidths returns the bandwidths of the products, in ascending order.
+func (d facetDistribution) bandwidths() []int64 {
+	bandwidths := []int64{}
+	for _, value := range d.values("bandwidth") {
+		if bandwidth, err := strconv.ParseInt(value, 10, 64); err == nil {
+			bandwidths = append(bandwidths, bandwidth)
+		}
+	}
+	sort.Slice(bandwidths, func(i, j int) bool { return bandwidths[i] < bandwidths[j] })
+	return bandwidths
+}
+
+// locationSearches returns the searches of the products at each location, given the facet distributions of
+// the searches of the locations of every kind. Only the locations listed in `names` are searched when set.
+func locationSearches(distributions []facetDistribution, names []string) []locationSearch {
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var searches []locationSearch
+	for i, kind := range locationKinds {
+		locations := map[string]bool{}
+		for _, facet := range kind.locationFacets {
+			for _, location := range distributions[i].values(facet) {
+				locations[location] = len(names) == 0 || wanted[location]
+			}
+		}
+
+		values := []string{""}
+		if kind.byFacet != "" {
+			values = distributions[i].values(kind.byFacet)
+		}
+		for location, ok := range locations {
+			if !ok {
+				continue
+			}
+			for _, value := range values {
+				searches = append(searches, locationSearch{kind: kind, location: location, value: value})
+			}
+		}
+	}
+
+	// searches are sorted by location, then in the order of the kinds, so the locations are built in order
+	kindOrder := map[string]int{}
+	for i, kind := range locationKinds {
+		kindOrder[kind.kind.attribute] = i
+	}
+	sort.SliceStable(searches, func(i, j int) bool {
+		if searches[i].location != searches[j].location {
+			return searches[i].location < searches[j].location
+		}
+		if searches[i].kind.kind.attribute != searches[j].kind.kind.attribute {
+			return kindOrder[searches[i].kind.kind.attribute] < kindOrder[searches[j].kind.kind.attribute]
+		}
+		return searches[i].value < searches[j].value
+	})
+	return searches
+}
+
+// catalogLocation is a location of the catalog with the products available there.
+type catalogLocation struct {
+	Name           string                  `tfsdk:"name"`
+	Underlays      []string                `tfsdk:"underlays"`
+	ProductKinds   []string                `tfsdk:"product_kinds"`
+	Clouds         []cloudLocation         `tfsdk:"clouds"`
+	Transport      *locationProducts       `tfsdk:"transport"`
+	PhysicalAccess *locationProducts       `tfsdk:"physical_access"`
+	VirtualAccess  []virtualAccessLocation `tfsdk:"virtual_access"`
+	PhysicalPort   *locationProducts       `tfsdk:"physical_port"`
+}
+
+// cloudLocation holds the cloud products of a cloud service provider at a location.
+type cloudLocation struct {
+	CSPName    string   `tfsdk:"csp_name"`
+	CSPRegions []string `tfsdk:"csp_regions"`
+	CSPCities  []string `tfsdk:"csp_cities"`
+	Bandwidths []int64  `tfsdk:"bandwidths"`
+}
+
+// virtualAccessLocation holds the virtual access products of a provider at a location.
+type virtualAccessLocation struct {
+	Provider   string  `tfsdk:"provider"`
+	Bandwidths []int64 `tfsdk:"bandwidths"`
+}
+
+// locationProducts holds the products of a kind at a location.
+type locationProducts struct {
+	Bandwidths []int64 `tfsdk:"bandwidths"`
+}
+
+// newLocations builds the locations from the facet distributions of the searches of their products, in
+// the order of the searches. Searches without products are ignored.
+func newLocations(searches []locationSearch, distributions []facetDistribution) []catalogLocation {
+	var locations []catalogLocation
+	for i, search := range searches {
+		d := distributions[i]
+		bandwidths := d.bandwidths()
+		if len(bandwidths) == 0 {
+			continue
+		}
+
+		if len(locations) == 0 || locations[len(locations)-1].Name != search.location {
+			locations = append(locations, catalogLocation{Name: search.location, ProductKinds: []string{}})
+		}
+		l := &locations[len(locations)-1]
+
+		switch search.kind.kind.attribute {
+		case cloudSearchKind.attribute:
+			l.Clouds = append(l.Clouds, cloudLocation{
+				CSPName:    search.value,
+				CSPRegions: d.values("cspRegion"),
+				CSPCities:  d.values("cspCity"),
+				Bandwidths: bandwidths,
+			})
+		case transportSearchKind.attribute:
+			l.Transport = &locationProducts{Bandwidths: bandwidths}
+		case physicalAccessSearchKind.attribute:
+			l.PhysicalAccess = &locationProducts{Bandwidths: bandwidths}
+		case virtualAccessSearchKind.attribute:
+			l.VirtualAccess = append(l.VirtualAccess, virtualAccessLocation{Provider: search.value, Bandwidths: bandwidths})
+		case physicalPortSearchKind.attribute:
+			l.PhysicalPort = &locationProducts{Bandwidths: bandwidths}
+		}
+
+		if len(l.ProductKinds) == 0 || l.ProductKinds[len(l.ProductKinds)-1] != search.kind.kind.attribute {
+			l.ProductKinds = append(l.ProductKinds, search.kind.kind.attribute)
+		}
+		for _, underlay := range d.values("locationUnderlay") {
+			if !slices.Contains(l.Underlays, underlay) {
+				l.Underlays = append(l.Underlays, underlay)
+			}
+		}
+		sort.Strings(l.Underlays)
+	}
+	return locations
+}
@@ -0,0 +1,201 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+type locationsDataSource struct {
+	client *meilisearch.Client
+}
+
+type locationsDataSourceModel struct {
+	Names     []string          `tfsdk:"names"`
+	Locations []catalogLocation `tfsdk:"locations"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &locationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &locationsDataSource{}
+)
+
+func NewLocationsDataSource() datasource.DataSource {
+	return &locationsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *locationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+// bandwidthsAttribute is the schema of the bandwidths offered at a location.
+var bandwidthsAttribute = schema.ListAttribute{
+	MarkdownDescription: "Bandwidths offered, in ascending order",
+	ElementType:         types.Int64Type,
+	Computed:            true,
+}
+
+// locationProductsAttribute returns the schema of the products of a kind at a location.
+func locationProductsAttribute(kind string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s products at the location, null when there is none", kind),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"bandwidths": bandwidthsAttribute,
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *locationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve the locations of the catalog, with the kinds of products available at each location
+and the bandwidths they offer. The transport products are available at both their source and destination locations.`,
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the locations to retrieve. Every location of the catalog is retrieved when not set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"locations": schema.ListNestedAttribute{
+				MarkdownDescription: "The locations of the catalog, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the location",
+							Computed:            true,
+						},
+						"underlays": schema.ListAttribute{
+							MarkdownDescription: "Underlay locations of the products at the location",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"product_kinds": schema.ListAttribute{
+							MarkdownDescription: "Kinds of products available at the location, among **cloud**, **transport**, **physical_access**, **virtual_access** and **physical_port**",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"clouds": schema.ListNestedAttribute{
+							MarkdownDescription: "The cloud products at the location, by cloud service provider",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"csp_name": schema.StringAttribute{
+										MarkdownDescription: "Name of the cloud service provider",
+										Computed:            true,
+									},
+									"csp_regions": schema.ListAttribute{
+										MarkdownDescription: "Regions of the cloud service provider",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"csp_cities": schema.ListAttribute{
+										MarkdownDescription: "Cities of the cloud service provider",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"bandwidths": bandwidthsAttribute,
+								},
+							},
+						},
+						"transport":       locationProductsAttribute("transport"),
+						"physical_access": locationProductsAttribute("physical access"),
+						"virtual_access": schema.ListNestedAttribute{
+							MarkdownDescription: "The virtual access products at the location, by provider",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"provider": schema.StringAttribute{
+										MarkdownDescription: "Provider of the virtual access products",
+										Computed:            true,
+									},
+									"bandwidths": bandwidthsAttribute,
+								},
+							},
+						},
+						"physical_port": locationProductsAttribute("physical port"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *locationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.CatalogClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *locationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data locationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Search the locations of every kind of products
+	locationsSearch := &meilisearch.MultiSearchRequest{}
+	for _, kind := range locationKinds {
+		locationsSearch.Queries = append(locationsSearch.Queries, kind.locationsRequest())
+	}
+	distributions, err := d.multiSearchFacets(locationsSearch)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Locations", err.Error())
+		return
+	}
+
+	// Then the products available at each location
+	searches := locationSearches(distributions, data.Names)
+	data.Locations = []catalogLocation{}
+	if len(searches) > 0 {
+		productsSearch := &meilisearch.MultiSearchRequest{}
+		for _, search := range searches {
+			productsSearch.Queries = append(productsSearch.Queries, search.request())
+		}
+		distributions, err = d.multiSearchFacets(productsSearch)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Autonomi Locations", err.Error())
+			return
+		}
+		data.Locations = newLocations(searches, distributions)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// multiSearchFacets runs the sub-queries in a single request to the catalog, returning their facet distributions.
+func (d *locationsDataSource) multiSearchFacets(multiSearch *meilisearch.MultiSearchRequest) ([]facetDistribution, error) {
+	respProducts, err := d.client.MultiSearch(multiSearch)
+	if err != nil {
+		return nil, err
+	}
+	return decodeFacetDistributions(respProducts, len(multiSearch.Queries))
+}
@@ -0,0 +1,94 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationSearchRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		search locationSearch
+		expect *meilisearch.SearchRequest
+	}{
+		{
+			name:   "transport products at either end",
+			search: locationSearch{kind: locationKinds[1], location: "Equinix FR5"},
+			expect: &meilisearch.SearchRequest{
+				IndexUID: "transportproduct",
+				Filter:   []string{`location = "Equinix FR5" OR locationTo = "Equinix FR5"`},
+				Facets:   []string{"bandwidth"},
+				Limit:    1,
+			},
+		},
+		{
+			name:   "virtual access products of a provider",
+			search: locationSearch{kind: locationKinds[3], location: "Equinix FR5", value: "MEGAPORT"},
+			expect: &meilisearch.SearchRequest{
+				IndexUID: "accessproduct",
+				Filter:   []string{`type = "VIRTUAL"`, `location = "Equinix FR5"`, `provider = "MEGAPORT"`},
+				Facets:   []string{"bandwidth", "locationUnderlay"},
+				Limit:    1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, tc.search.request())
+	}
+}
+
+func TestLocations(t *testing.T) {
+	// distributions of the locations of the cloud, transport, physical access, virtual access and physical
+	// port products
+	locationDistributions := []facetDistribution{
+		{"location": {"FR5": 2}, "cspName": {"AWS": 1, "Azure": 1}},
+		{"location": {"FR5": 1}, "locationTo": {"TH2": 1}},
+		{"location": {"TH2": 1}},
+		{"location": {}, "provider": {"MEGAPORT": 1}},
+		{"location": {"FR5": 1}},
+	}
+
+	searches := locationSearches(locationDistributions, nil)
+	assert.Len(t, searches, 6)
+	assert.Len(t, locationSearches(locationDistributions, []string{"TH2"}), 2)
+
+	productDistributions := make([]facetDistribution, len(searches))
+	for i, search := range searches {
+		switch {
+		case search.location == "FR5" && search.value == "AWS":
+			productDistributions[i] = facetDistribution{"bandwidth": {"1000": 1, "200": 2}, "cspRegion": {"eu-central-1": 3}, "locationUnderlay": {"FR5-U": 3}}
+		case search.location == "FR5" && search.value == "Azure":
+			// no Azure products at FR5
+			productDistributions[i] = facetDistribution{}
+		default:
+			productDistributions[i] = facetDistribution{"bandwidth": {"100": 1}}
+		}
+	}
+
+	assert.Equal(t, []catalogLocation{
+		{
+			Name:         "FR5",
+			Underlays:    []string{"FR5-U"},
+			ProductKinds: []string{productKindCloud, productKindTransport, productKindPhysicalPort},
+			Clouds: []cloudLocation{{
+				CSPName:    "AWS",
+				CSPRegions: []string{"eu-central-1"},
+				CSPCities:  []string{},
+				Bandwidths: []int64{200, 1000},
+			}},
+			Transport:    &locationProducts{Bandwidths: []int64{100}},
+			PhysicalPort: &locationProducts{Bandwidths: []int64{100}},
+		},
+		{
+			Name:           "TH2",
+			ProductKinds:   []string{productKindTransport, productKindPhysicalAccess},
+			Transport:      &locationProducts{Bandwidths: []int64{100}},
+			PhysicalAccess: &locationProducts{Bandwidths: []int64{100}},
+		},
+	}, newLocations(searches, productDistributions))
+}
@@ -0,0 +1,130 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+type nodeDataSource struct {
+	client *autonomisdk.Client
+}
+
+type nodeDataSourceModel struct {
+	WorkspaceID types.String     `tfsdk:"workspace_id"`
+	Recent      types.Bool       `tfsdk:"most_recent"`
+	Filters     []filters.Filter `tfsdk:"filters"`
+	Node        *nodeHit         `tfsdk:"node"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeDataSource{}
+)
+
+func NewNodeDataSource() datasource.DataSource {
+	return &nodeDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *nodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve a single node of a workspace by filters.
+If zero, or more than one, node(s) are retrieved with the filters, this datasource raises an error.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "Return the most recently created node when more than one matches the filters.",
+				Optional:            true,
+			},
+			"filters": filters.AutonomiAttribute(nodeFilters...),
+			"node": schema.SingleNestedAttribute{
+				MarkdownDescription: "The **node** matching the filters.",
+				Computed:            true,
+				Attributes:          nodeAttributes(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nodeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data nodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := d.client.ListNodes(ctx, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Nodes",
+			"Could not list the nodes of workspace "+data.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	filteredNodes, err := filters.ApplyFields(*nodes, data.Filters, nodeField)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting filters", err.Error())
+		return
+	}
+
+	if len(filteredNodes) == 0 {
+		resp.Diagnostics.AddError("No node found", "No node of workspace "+data.WorkspaceID.ValueString()+" matches the filters.")
+		return
+	}
+
+	if len(filteredNodes) > 1 {
+		if !data.Recent.ValueBool() {
+			resp.Diagnostics.AddError("Request got more than one hit, please set most_recent=true",
+				fmt.Sprintf("%d nodes of workspace %s match the filters.", len(filteredNodes), data.WorkspaceID.ValueString()))
+			return
+		}
+		sort.Slice(filteredNodes, func(i, j int) bool {
+			return filteredNodes[i].CreatedAt.After(filteredNodes[j].CreatedAt)
+		})
+	}
+
+	node := nodeHitFromAPI(filteredNodes[0])
+	data.Node = &node
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,296 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+// nodeFilters are the fields the nodes can be filtered on.
+var nodeFilters = []string{"id", "name", "type", "administrative_state", "sku", "location", "bandwidth", "cloud_provider"}
+
+type nodesDataSource struct {
+	client *autonomisdk.Client
+}
+
+type nodeServiceKey struct {
+	ID             types.String      `tfsdk:"id"`
+	Name           types.String      `tfsdk:"name"`
+	ExpirationDate timetypes.RFC3339 `tfsdk:"expiration_date"`
+}
+
+type nodeHit struct {
+	ID             types.String      `tfsdk:"id"`
+	WorkspaceID    types.String      `tfsdk:"workspace_id"`
+	CreatedAt      timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt      timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt     timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name           types.String      `tfsdk:"name"`
+	State          types.String      `tfsdk:"administrative_state"`
+	Type           types.String      `tfsdk:"type"`
+	Product        elementProduct    `tfsdk:"product"`
+	CloudProvider  types.String      `tfsdk:"cloud_provider"`
+	Vlan           types.Int64       `tfsdk:"vlan"`
+	ConnectionID   types.String      `tfsdk:"connection_id"`
+	DxconID        types.String      `tfsdk:"dxcon_id"`
+	PhysicalPortID types.String      `tfsdk:"physical_port_id"`
+	ServiceKey     *nodeServiceKey   `tfsdk:"service_key"`
+}
+
+type nodesDataSourceModel struct {
+	WorkspaceID types.String     `tfsdk:"workspace_id"`
+	Filters     []filters.Filter `tfsdk:"filters"`
+	Nodes       []nodeHit        `tfsdk:"nodes"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodesDataSource{}
+)
+
+func NewNodesDataSource() datasource.DataSource {
+	return &nodesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *nodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Datasource to retrieve the list of the nodes of a workspace by filters.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"filters":      filters.AutonomiAttribute(nodeFilters...),
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "The **nodes** of the workspace matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nodeAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// nodeAttributes returns the attributes of a node.
+func nodeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The **ID** of the node.",
+			Computed:            true,
+		},
+		"workspace_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the workspace to which the node belongs.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Creation date of the node",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Update date of the node",
+			Computed:            true,
+		},
+		"deployed_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Deployment date of the node, null until it is deployed",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The **name** of the node.",
+			Computed:            true,
+		},
+		"administrative_state": schema.StringAttribute{
+			MarkdownDescription: `Administrative state of the node [creation_pending, creation_proceed, creation_error,
+deployed, delete_pending, delete_proceed, delete_error]`,
+			Computed: true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the node [cloud, access]",
+			Computed:            true,
+		},
+		"product": elementProductAttribute(),
+		"cloud_provider": schema.StringAttribute{
+			MarkdownDescription: "Cloud provider of the cloud nodes [AWS, Azure, GCP], null for the access nodes.",
+			Computed:            true,
+		},
+		"vlan": schema.Int64Attribute{
+			MarkdownDescription: "VLAN of the node",
+			Computed:            true,
+		},
+		"connection_id": schema.StringAttribute{
+			MarkdownDescription: "Connection ID of the AWS cloud nodes",
+			Computed:            true,
+		},
+		"dxcon_id": schema.StringAttribute{
+			MarkdownDescription: "Direct Connect connection ID of the AWS cloud nodes",
+			Computed:            true,
+		},
+		"physical_port_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the physical port of the physical access nodes",
+			Computed:            true,
+		},
+		"service_key": schema.SingleNestedAttribute{
+			MarkdownDescription: "Service key of the virtual access nodes",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "ID of the service key",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "name of the service key",
+					Computed:            true,
+				},
+				"expiration_date": schema.StringAttribute{
+					CustomType:          timetypes.RFC3339Type{},
+					MarkdownDescription: "expiration date of the service key",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// nodeCloudProvider returns the cloud provider of the node, told by its provider configuration, and an
+// empty string for the access nodes.
+func nodeCloudProvider(node *autonomisdkmodel.Node) string {
+	if node.Type != autonomisdkmodel.NodeTypeCloud || node.ProviderConfig == nil {
+		return ""
+	}
+	switch {
+	case node.ProviderConfig.AccountID != "":
+		return "AWS"
+	case node.ProviderConfig.ServiceKey != "":
+		return "Azure"
+	case node.ProviderConfig.PairingKey != "":
+		return "GCP"
+	}
+	return ""
+}
+
+// nodeHitFromAPI maps the API node onto the model. The secrets of the cloud provider configuration are
+// not exposed.
+func nodeHitFromAPI(node autonomisdkmodel.Node) nodeHit {
+	hit := nodeHit{
+		ID:             types.StringValue(node.ID.String()),
+		WorkspaceID:    types.StringValue(node.WorkspaceID.String()),
+		CreatedAt:      timestampValue(node.CreatedAt),
+		UpdatedAt:      timestampValue(node.UpdatedAt),
+		DeployedAt:     timestampValue(node.DeployedAt),
+		Name:           types.StringValue(node.Name),
+		State:          types.StringValue(node.State.String()),
+		Type:           types.StringValue(node.Type.String()),
+		Product:        elementProductFromAPI(node.Product),
+		CloudProvider:  stringValue(nodeCloudProvider(&node)),
+		Vlan:           types.Int64Value(node.Vlan),
+		ConnectionID:   stringValue(node.ConnectionID),
+		DxconID:        stringValue(node.DxconID),
+		PhysicalPortID: types.StringNull(),
+	}
+	if node.PhysicalPort.ID != uuid.Nil {
+		hit.PhysicalPortID = types.StringValue(node.PhysicalPort.ID.String())
+	}
+	if node.ServiceKey.ID != "" {
+		hit.ServiceKey = &nodeServiceKey{
+			ID:             types.StringValue(node.ServiceKey.ID),
+			Name:           types.StringValue(node.ServiceKey.Name),
+			ExpirationDate: timestampValue(node.ServiceKey.ExpirationDate),
+		}
+	}
+	return hit
+}
+
+// nodeField returns the value of the field of the node filtered by the filters.
+func nodeField(node *autonomisdkmodel.Node, name string) (string, bool) {
+	switch name {
+	case "id":
+		return node.ID.String(), true
+	case "name":
+		return node.Name, true
+	case "type":
+		return node.Type.String(), true
+	case "administrative_state":
+		return node.State.String(), true
+	case "sku":
+		return node.Product.SKU, true
+	case "location":
+		return node.Product.Location, true
+	case "cloud_provider":
+		return nodeCloudProvider(node), true
+	case "bandwidth":
+		return strconv.Itoa(node.Product.Bandwidth), true
+	}
+	return "", false
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data nodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := d.client.ListNodes(ctx, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Nodes",
+			"Could not list the nodes of workspace "+data.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	filteredNodes, err := filters.ApplyFields(*nodes, data.Filters, nodeField)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting filters", err.Error())
+		return
+	}
+
+	data.Nodes = []nodeHit{}
+	for _, node := range filteredNodes {
+		data.Nodes = append(data.Nodes, nodeHitFromAPI(node))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,82 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeField(t *testing.T) {
+	node := autonomisdkmodel.Node{
+		BaseModel:      autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		Name:           "aws",
+		State:          autonomisdkmodel.AdministrativeStateDeployed,
+		Type:           autonomisdkmodel.NodeTypeCloud,
+		Product:        autonomisdkmodel.Product{SKU: "CLOUD-FR5", Location: "FR5", Bandwidth: 100},
+		ProviderConfig: &autonomisdkmodel.ProviderCloudConfig{AccountID: "123456789012"},
+	}
+	expect := map[string]string{
+		"id":                   "00000000-0000-0000-0000-000000000001",
+		"name":                 "aws",
+		"type":                 autonomisdkmodel.NodeTypeCloud.String(),
+		"administrative_state": autonomisdkmodel.AdministrativeStateDeployed.String(),
+		"sku":                  "CLOUD-FR5",
+		"location":             "FR5",
+		"bandwidth":            "100",
+		"cloud_provider":       "AWS",
+	}
+
+	assert.Len(t, nodeFilters, len(expect))
+	for _, name := range nodeFilters {
+		t.Log(name)
+
+		value, ok := nodeField(&node, name)
+		assert.True(t, ok)
+		assert.Equal(t, expect[name], value)
+	}
+	_, ok := nodeField(&node, "vlan")
+	assert.False(t, ok)
+}
+
+func TestNodeCloudProvider(t *testing.T) {
+	tests := []struct {
+		name   string
+		node   autonomisdkmodel.Node
+		expect string
+	}{
+		{
+			name:   "access node",
+			node:   autonomisdkmodel.Node{Type: autonomisdkmodel.NodeTypeAccess},
+			expect: "",
+		},
+		{
+			name:   "cloud node without provider configuration",
+			node:   autonomisdkmodel.Node{Type: autonomisdkmodel.NodeTypeCloud},
+			expect: "",
+		},
+		{
+			name: "Azure cloud node",
+			node: autonomisdkmodel.Node{
+				Type:           autonomisdkmodel.NodeTypeCloud,
+				ProviderConfig: &autonomisdkmodel.ProviderCloudConfig{ServiceKey: "service-key"},
+			},
+			expect: "Azure",
+		},
+		{
+			name: "GCP cloud node",
+			node: autonomisdkmodel.Node{
+				Type:           autonomisdkmodel.NodeTypeCloud,
+				ProviderConfig: &autonomisdkmodel.ProviderCloudConfig{PairingKey: "pairing-key"},
+			},
+			expect: "GCP",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, nodeCloudProvider(&tc.node))
+	}
+}
@@ -0,0 +1,250 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+type physicalPortVlansDataSource struct {
+	client *autonomisdk.Client
+}
+
+type physicalPortVlanRange struct {
+	From types.Int64 `tfsdk:"from"`
+	To   types.Int64 `tfsdk:"to"`
+}
+
+type physicalPortVlansDataSourceModel struct {
+	ID                 types.String           `tfsdk:"id"`
+	Name               types.String           `tfsdk:"name"`
+	VlanRange          *physicalPortVlanRange `tfsdk:"vlan_range"`
+	NextCount          types.Int64            `tfsdk:"next_count"`
+	UsedVlans          types.Set              `tfsdk:"used_vlans"`
+	FreeVlans          []int64                `tfsdk:"free_vlans"`
+	NextFreeVlans      []int64                `tfsdk:"next_free_vlans"`
+	AvailableBandwidth types.Int64            `tfsdk:"available_bandwidth"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &physicalPortVlansDataSource{}
+	_ datasource.DataSourceWithConfigure = &physicalPortVlansDataSource{}
+)
+
+func NewPhysicalPortVlansDataSource() datasource.DataSource {
+	return &physicalPortVlansDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *physicalPortVlansDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_physical_port_vlans"
+}
+
+// Schema defines the schema for the data source.
+func (d *physicalPortVlansDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	vlanValidators := []validator.Int64{
+		int64validator.Between(physicalport.MinVLAN, physicalport.MaxVLAN),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve the VLANs used and free on a physical port, looked up by ID or by name,
+as reported by the API.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The **ID** of the physical port. Exactly one of **id** and **name** must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The exact **name** of the physical port. Exactly one of **id** and **name** must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"vlan_range": schema.SingleNestedAttribute{
+				MarkdownDescription: fmt.Sprintf("Range of VLANs **next_free_vlans** are taken from. Defaults to %d-%d.", physicalport.MinVLAN, physicalport.MaxVLAN),
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"from": schema.Int64Attribute{
+						MarkdownDescription: "Lowest VLAN of the range",
+						Required:            true,
+						Validators:          vlanValidators,
+					},
+					"to": schema.Int64Attribute{
+						MarkdownDescription: "Highest VLAN of the range",
+						Required:            true,
+						Validators:          vlanValidators,
+					},
+				},
+			},
+			"next_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of free VLANs returned in **next_free_vlans**. Defaults to 1.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"used_vlans": schema.SetAttribute{
+				MarkdownDescription: "The VLANs used on the physical port.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"free_vlans": schema.ListAttribute{
+				MarkdownDescription: "The VLANs free on the physical port, in ascending order.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"next_free_vlans": schema.ListAttribute{
+				MarkdownDescription: "The **next_count** lowest VLANs free in **vlan_range**, fewer when the range lacks free VLANs.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"available_bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "The **available bandwidth** on the physical port.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *physicalPortVlansDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *physicalPortVlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data physicalPortVlansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := d.physicalPort(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Physical Port", err.Error())
+		return
+	}
+
+	from, to := int64(physicalport.MinVLAN), int64(physicalport.MaxVLAN)
+	if data.VlanRange != nil {
+		from, to = data.VlanRange.From.ValueInt64(), data.VlanRange.To.ValueInt64()
+		if from > to {
+			resp.Diagnostics.AddAttributeError(path.Root("vlan_range"), "Invalid VLAN range",
+				fmt.Sprintf("The lowest VLAN of the range, %d, is higher than its highest one, %d.", from, to))
+			return
+		}
+	}
+	nextCount := int64(1)
+	if !data.NextCount.IsNull() {
+		nextCount = data.NextCount.ValueInt64()
+	}
+
+	freeVlans := unusedVlans(port.UsedVLANs, physicalport.MinVLAN, physicalport.MaxVLAN)
+	nextFreeVlans := unusedVlans(port.UsedVLANs, from, to)
+	if int64(len(nextFreeVlans)) > nextCount {
+		nextFreeVlans = nextFreeVlans[:nextCount]
+	}
+
+	usedVlansSet, diags := types.SetValueFrom(ctx, types.Int64Type, port.UsedVLANs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(port.ID.String())
+	data.Name = types.StringValue(port.Name)
+	data.UsedVlans = usedVlansSet
+	data.FreeVlans = freeVlans
+	data.NextFreeVlans = nextFreeVlans
+	data.AvailableBandwidth = types.Int64Value(int64(port.AvailableBandwidth))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// unusedVlans returns the VLANs of the [from, to] range not used on the physical port, in ascending order.
+func unusedVlans(used []int64, from, to int64) []int64 {
+	usedVlans := make(map[int64]bool, len(used))
+	for _, vlan := range used {
+		usedVlans[vlan] = true
+	}
+
+	free := []int64{}
+	for vlan := from; vlan <= to; vlan++ {
+		if !usedVlans[vlan] {
+			free = append(free, vlan)
+		}
+	}
+	return free
+}
+
+// physicalPort reads the physical port by ID, or looks it up by name among the ports of the account,
+// failing when no port, or more than one, has the name.
+func (d *physicalPortVlansDataSource) physicalPort(ctx context.Context, data physicalPortVlansDataSourceModel) (*autonomisdkmodel.PhysicalPort, error) {
+	if !data.ID.IsNull() {
+		port, err := d.client.GetPhysicalPort(ctx, data.ID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not read physical port ID %s: %w", data.ID.ValueString(), err)
+		}
+		return port, nil
+	}
+
+	ports, err := d.client.ListPort(autonomisdk.WithAdministrativeState(autonomisdkmodel.AdministrativeStateCreated))
+	if err != nil {
+		return nil, err
+	}
+
+	var found []autonomisdkmodel.PhysicalPort
+	for _, port := range *ports {
+		if port.Name == data.Name.ValueString() {
+			found = append(found, port)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no physical port is named %q", data.Name.ValueString())
+	case 1:
+		return &found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, port := range found {
+		ids = append(ids, port.ID.String())
+	}
+	return nil, fmt.Errorf("%d physical ports are named %q: %s. Look the port up by id instead",
+		len(found), data.Name.ValueString(), strings.Join(ids, ", "))
+}
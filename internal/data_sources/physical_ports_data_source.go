@@ -9,8 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
-	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
 	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 )
 
 type physicalPortsDataSource struct {
@@ -174,7 +174,7 @@ func (d *physicalPortsDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	clients, ok := req.ProviderData.(models.Clients)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
@@ -0,0 +1,97 @@
+package datasources
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/catalog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Kinds of the products of the catalog.
+const (
+	productKindCloud          = "cloud"
+	productKindTransport      = "transport"
+	productKindPhysicalAccess = "physical_access"
+	productKindVirtualAccess  = "virtual_access"
+	productKindPhysicalPort   = "physical_port"
+)
+
+// productIndexes lists every product index of the catalog.
+var productIndexes = []string{"cloudproduct", "transportproduct", "accessproduct", "portproduct"}
+
+// catalogProduct is a product of any index of the catalog, the fields specific to another kind of product
+// being left empty.
+type catalogProduct struct {
+	models.Product
+	LocationTo         string `json:"locationTo"`
+	LocationToUnderlay string `json:"locationToUnderlay"`
+	CSPName            string `json:"cspName"`
+	Type               string `json:"type"`
+}
+
+// productMatch is a product of the catalog listed in an index.
+type productMatch struct {
+	Index   string
+	Product catalogProduct
+}
+
+// kind returns the kind of the product, telling physical and virtual access products apart.
+func (m productMatch) kind() string {
+	switch m.Index {
+	case "cloudproduct":
+		return productKindCloud
+	case "transportproduct":
+		return productKindTransport
+	case "portproduct":
+		return productKindPhysicalPort
+	}
+	if m.Product.Type == models.VIRTUAL.String() {
+		return productKindVirtualAccess
+	}
+	return productKindPhysicalAccess
+}
+
+// searchProductSKU returns the products of every index of the catalog having the SKU, looked up in a
+// single request. At most two products are returned by index, enough to tell the SKU is ambiguous.
+func searchProductSKU(client *meilisearch.Client, sku string) ([]productMatch, error) {
+	lookups := make([]catalog.SKULookup, 0, len(productIndexes))
+	for _, index := range productIndexes {
+		lookups = append(lookups, catalog.SKULookup{Index: index, SKU: sku})
+	}
+	hits, err := catalog.LookupSKUs(client, lookups, 2)
+	if err != nil {
+		return nil, fmt.Errorf("could not search the catalog: %w", err)
+	}
+
+	var matches []productMatch
+	for i, index := range productIndexes {
+		for _, hit := range hits[i] {
+			var product catalogProduct
+			if err := json.Unmarshal(hit, &product); err != nil {
+				return nil, err
+			}
+			matches = append(matches, productMatch{Index: index, Product: product})
+		}
+	}
+	return matches, nil
+}
+
+// singleProduct returns the only product having the SKU, failing when the catalog lists none, or more than one.
+func singleProduct(sku string, matches []productMatch) (productMatch, error) {
+	switch len(matches) {
+	case 0:
+		return productMatch{}, fmt.Errorf("the SKU %q is not listed in the catalog", sku)
+	case 1:
+		return matches[0], nil
+	}
+
+	kinds := make([]string, 0, len(matches))
+	for _, match := range matches {
+		kinds = append(kinds, match.kind())
+	}
+	return productMatch{}, fmt.Errorf("the SKU %q is ambiguous, %d products of the catalog have it: %s",
+		sku, len(matches), strings.Join(kinds, ", "))
+}
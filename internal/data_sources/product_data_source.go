@@ -0,0 +1,185 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+type productDataSource struct {
+	client *meilisearch.Client
+}
+
+type productHit struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	Provider           types.String  `tfsdk:"provider"`
+	Duration           types.Int64   `tfsdk:"duration"`
+	Location           types.String  `tfsdk:"location"`
+	LocationUnderlay   types.String  `tfsdk:"location_underlay"`
+	LocationTo         types.String  `tfsdk:"location_to"`
+	LocationToUnderlay types.String  `tfsdk:"location_to_underlay"`
+	Bandwidth          types.Int64   `tfsdk:"bandwidth"`
+	Date               types.String  `tfsdk:"date"`
+	PriceNRC           types.Float64 `tfsdk:"price_nrc"`
+	PriceMRC           types.Float64 `tfsdk:"price_mrc"`
+	CostNRC            types.Float64 `tfsdk:"cost_nrc"`
+	CostMRC            types.Float64 `tfsdk:"cost_mrc"`
+	SKU                types.String  `tfsdk:"sku"`
+	CSPName            types.String  `tfsdk:"csp_name"`
+	Type               types.String  `tfsdk:"type"`
+}
+
+type productDataSourceModel struct {
+	SKU   types.String `tfsdk:"sku"`
+	Kind  types.String `tfsdk:"kind"`
+	Index types.String `tfsdk:"index"`
+	Hit   *productHit  `tfsdk:"hit"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &productDataSource{}
+	_ datasource.DataSourceWithConfigure = &productDataSource{}
+)
+
+func NewProductDataSource() datasource.DataSource {
+	return &productDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *productDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+// Schema defines the schema for the data source.
+func (d *productDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve a product by SKU, whatever its kind, searching every product index of the catalog.
+If the SKU is not listed in the catalog, or more than one product has it, this datasource raises an error.`,
+		Attributes: map[string]schema.Attribute{
+			"sku": schema.StringAttribute{
+				MarkdownDescription: "The **SKU** of the product",
+				Required:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The kind of the product, among **%s**, **%s**, **%s**, **%s** and **%s**",
+					productKindCloud, productKindTransport, productKindPhysicalAccess, productKindVirtualAccess, productKindPhysicalPort),
+				Computed: true,
+			},
+			"index": schema.StringAttribute{
+				MarkdownDescription: "The catalog index listing the product",
+				Computed:            true,
+			},
+			"hit": schema.SingleNestedAttribute{
+				MarkdownDescription: `The **hit** attribute contains the product having the SKU.
+The attributes specific to another kind of product are null.`,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.Int64Attribute{Computed: true},
+					"provider":          schema.StringAttribute{Computed: true},
+					"duration":          schema.Int64Attribute{Computed: true},
+					"location":          schema.StringAttribute{Computed: true},
+					"location_underlay": schema.StringAttribute{Computed: true},
+					"location_to": schema.StringAttribute{
+						MarkdownDescription: "Destination location of the transport products",
+						Computed:            true,
+					},
+					"location_to_underlay": schema.StringAttribute{
+						MarkdownDescription: "Destination underlay location of the transport products",
+						Computed:            true,
+					},
+					"bandwidth": schema.Int64Attribute{Computed: true},
+					"date":      schema.StringAttribute{Computed: true},
+					"price_nrc": schema.Float64Attribute{Computed: true},
+					"price_mrc": schema.Float64Attribute{Computed: true},
+					"cost_nrc":  schema.Float64Attribute{Computed: true},
+					"cost_mrc":  schema.Float64Attribute{Computed: true},
+					"sku":       schema.StringAttribute{Computed: true},
+					"csp_name": schema.StringAttribute{
+						MarkdownDescription: "Cloud service provider of the cloud products",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the access products, **PHYSICAL** or **VIRTUAL**",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *productDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.CatalogClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *productDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data productDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches, err := searchProductSKU(d.client, data.SKU.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Products", err.Error())
+		return
+	}
+
+	match, err := singleProduct(data.SKU.ValueString(), matches)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sku"), "Unable to Find the Product", err.Error())
+		return
+	}
+
+	p := match.Product
+	data.Kind = types.StringValue(match.kind())
+	data.Index = types.StringValue(match.Index)
+	data.Hit = &productHit{
+		ID:                 types.Int64Value(int64(p.ID)),
+		Provider:           types.StringValue(p.Provider),
+		Duration:           types.Int64Value(int64(p.Duration)),
+		Location:           types.StringValue(p.Location),
+		LocationUnderlay:   stringValue(p.LocationUnderlay),
+		LocationTo:         stringValue(p.LocationTo),
+		LocationToUnderlay: stringValue(p.LocationToUnderlay),
+		Bandwidth:          types.Int64Value(int64(p.Bandwidth)),
+		Date:               types.StringValue(p.Date),
+		PriceNRC:           types.Float64Value(float64(p.PriceNRC)),
+		PriceMRC:           types.Float64Value(float64(p.PriceMRC)),
+		CostNRC:            types.Float64Value(float64(p.CostNRC)),
+		CostMRC:            types.Float64Value(float64(p.CostMRC)),
+		SKU:                types.StringValue(p.SKU),
+		CSPName:            stringValue(p.CSPName),
+		Type:               stringValue(p.Type),
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleProduct(t *testing.T) {
+	cloud := productMatch{Index: "cloudproduct", Product: catalogProduct{Product: models.Product{SKU: "sku"}}}
+	virtualAccess := productMatch{Index: "accessproduct", Product: catalogProduct{Product: models.Product{SKU: "sku"}, Type: "VIRTUAL"}}
+
+	tests := []struct {
+		name    string
+		matches []productMatch
+		kind    string
+		err     error
+	}{
+		{
+			name:    "single product",
+			matches: []productMatch{virtualAccess},
+			kind:    productKindVirtualAccess,
+		},
+		{
+			name: "unknown SKU",
+			err:  errors.New(`the SKU "sku" is not listed in the catalog`),
+		},
+		{
+			name:    "SKU listed in two indexes",
+			matches: []productMatch{cloud, virtualAccess},
+			err:     errors.New(`the SKU "sku" is ambiguous, 2 products of the catalog have it: cloud, virtual_access`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		match, err := singleProduct("sku", tc.matches)
+		assert.Equal(t, tc.err, err)
+		if tc.err == nil {
+			assert.Equal(t, tc.kind, match.kind())
+		}
+	}
+}
+
+func TestProductMatchKind(t *testing.T) {
+	tests := []struct {
+		index       string
+		productType string
+		expect      string
+	}{
+		{index: "cloudproduct", expect: productKindCloud},
+		{index: "transportproduct", expect: productKindTransport},
+		{index: "accessproduct", productType: "PHYSICAL", expect: productKindPhysicalAccess},
+		{index: "accessproduct", productType: "VIRTUAL", expect: productKindVirtualAccess},
+		{index: "portproduct", expect: productKindPhysicalPort},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.index, tc.productType)
+
+		match := productMatch{Index: tc.index, Product: catalogProduct{Type: tc.productType}}
+		assert.Equal(t, tc.expect, match.kind())
+	}
+}
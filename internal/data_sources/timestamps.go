@@ -0,0 +1,15 @@
+package datasources
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+// timestampValue converts an API timestamp into an RFC 3339 value, a zero timestamp being converted to null.
+func timestampValue(t time.Time) timetypes.RFC3339 {
+	if t.IsZero() {
+		return timetypes.NewRFC3339Null()
+	}
+	return timetypes.NewRFC3339TimeValue(t)
+}
@@ -0,0 +1,143 @@
+package datasources
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	vertexKindNode      = "node"
+	vertexKindTransport = "transport"
+)
+
+// topologyVertex is a node or a transport of the workspace.
+type topologyVertex struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	State    string `json:"administrative_state"`
+	Location string `json:"location"`
+}
+
+// topologyEdge is an attachment of a node to a side of a transport.
+type topologyEdge struct {
+	ID          string `json:"id"`
+	NodeID      string `json:"node_id"`
+	TransportID string `json:"transport_id"`
+	Side        string `json:"side"`
+	State       string `json:"administrative_state"`
+}
+
+// topology is the graph of the nodes and the transports of a workspace, linked by their attachments.
+// The vertices and the edges are sorted, so the renderings of a workspace only change with it.
+type topology struct {
+	Vertices []topologyVertex `json:"vertices"`
+	Edges    []topologyEdge   `json:"edges"`
+}
+
+// newTopology builds the graph of the elements of a workspace.
+func newTopology(nodes []autonomisdkmodel.Node, transports []autonomisdkmodel.Transport, attachments []autonomisdkmodel.Attachment) topology {
+	t := topology{
+		Vertices: make([]topologyVertex, 0, len(nodes)+len(transports)),
+		Edges:    make([]topologyEdge, 0, len(attachments)),
+	}
+
+	for _, node := range nodes {
+		t.Vertices = append(t.Vertices, topologyVertex{
+			ID:       node.ID.String(),
+			Kind:     vertexKindNode,
+			Type:     node.Type.String(),
+			Name:     node.Name,
+			State:    node.State.String(),
+			Location: node.Product.Location,
+		})
+	}
+	for _, transport := range transports {
+		t.Vertices = append(t.Vertices, topologyVertex{
+			ID:       transport.ID.String(),
+			Kind:     vertexKindTransport,
+			Type:     vertexKindTransport,
+			Name:     transport.Name,
+			State:    transport.State.String(),
+			Location: transport.Product.Location,
+		})
+	}
+	for _, attachment := range attachments {
+		t.Edges = append(t.Edges, topologyEdge{
+			ID:          attachment.ID.String(),
+			NodeID:      attachment.NodeID,
+			TransportID: attachment.TransportID,
+			Side:        attachment.Side,
+			State:       attachment.State.String(),
+		})
+	}
+
+	sort.Slice(t.Vertices, func(i, j int) bool {
+		a, b := t.Vertices[i], t.Vertices[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+	sort.Slice(t.Edges, func(i, j int) bool {
+		a, b := t.Edges[i], t.Edges[j]
+		if a.TransportID != b.TransportID {
+			return a.TransportID < b.TransportID
+		}
+		if a.Side != b.Side {
+			return a.Side < b.Side
+		}
+		return a.NodeID < b.NodeID
+	})
+	return t
+}
+
+// states returns the administrative state of every element of the graph, by ID.
+func (t topology) states() map[string]string {
+	states := make(map[string]string, len(t.Vertices)+len(t.Edges))
+	for _, vertex := range t.Vertices {
+		states[vertex.ID] = vertex.State
+	}
+	for _, edge := range t.Edges {
+		states[edge.ID] = edge.State
+	}
+	return states
+}
+
+// dot renders the graph in the Graphviz DOT language, the nodes being drawn as boxes, the transports as
+// ellipses and the attachments as edges labelled with their side.
+func (t topology) dot(name string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "graph %s {\n", strconv.Quote(name))
+	for _, vertex := range t.Vertices {
+		shape := "box"
+		if vertex.Kind == vertexKindTransport {
+			shape = "ellipse"
+		}
+		label := fmt.Sprintf("%s\n%s %s\n%s", vertex.Name, vertex.Type, vertex.Location, vertex.State)
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", strconv.Quote(vertex.ID), strconv.Quote(label), shape)
+	}
+	for _, edge := range t.Edges {
+		fmt.Fprintf(&b, "  %s -- %s [label=%s];\n", strconv.Quote(edge.NodeID), strconv.Quote(edge.TransportID), strconv.Quote(edge.Side))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// json renders the graph in JSON.
+func (t topology) json() (string, error) {
+	graph, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(graph), nil
+}
@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopology(t *testing.T) {
+	nodeAWS := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	nodeDC := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	transport := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	attachmentA := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	attachmentZ := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+
+	graph := newTopology(
+		[]autonomisdkmodel.Node{
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: nodeDC},
+				Name:      "dc",
+				Type:      autonomisdkmodel.NodeTypeAccess,
+				State:     autonomisdkmodel.AdministrativeStateDeployed,
+				Product:   autonomisdkmodel.Product{Location: "LD5"},
+			},
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: nodeAWS},
+				Name:      "aws",
+				Type:      autonomisdkmodel.NodeTypeCloud,
+				State:     autonomisdkmodel.AdministrativeStateDeployed,
+				Product:   autonomisdkmodel.Product{Location: "FR5"},
+			},
+		},
+		[]autonomisdkmodel.Transport{
+			{
+				BaseModel: autonomisdkmodel.BaseModel{ID: transport},
+				Name:      "fr5-ld5",
+				State:     autonomisdkmodel.AdministrativeStateCreationPending,
+				Product:   autonomisdkmodel.Product{Location: "FR5"},
+			},
+		},
+		[]autonomisdkmodel.Attachment{
+			{
+				BaseModel:   autonomisdkmodel.BaseModel{ID: attachmentZ},
+				NodeID:      nodeDC.String(),
+				TransportID: transport.String(),
+				Side:        "Z",
+				State:       autonomisdkmodel.AdministrativeStateDeployed,
+			},
+			{
+				BaseModel:   autonomisdkmodel.BaseModel{ID: attachmentA},
+				NodeID:      nodeAWS.String(),
+				TransportID: transport.String(),
+				Side:        "A",
+				State:       autonomisdkmodel.AdministrativeStateDeployed,
+			},
+		},
+	)
+
+	assert.Equal(t, []topologyVertex{
+		{ID: nodeAWS.String(), Kind: "node", Type: "cloud", Name: "aws", State: "deployed", Location: "FR5"},
+		{ID: nodeDC.String(), Kind: "node", Type: "access", Name: "dc", State: "deployed", Location: "LD5"},
+		{ID: transport.String(), Kind: "transport", Type: "transport", Name: "fr5-ld5", State: "creation_pending", Location: "FR5"},
+	}, graph.Vertices)
+	assert.Equal(t, []topologyEdge{
+		{ID: attachmentA.String(), NodeID: nodeAWS.String(), TransportID: transport.String(), Side: "A", State: "deployed"},
+		{ID: attachmentZ.String(), NodeID: nodeDC.String(), TransportID: transport.String(), Side: "Z", State: "deployed"},
+	}, graph.Edges)
+
+	assert.Equal(t, map[string]string{
+		nodeAWS.String():     "deployed",
+		nodeDC.String():      "deployed",
+		transport.String():   "creation_pending",
+		attachmentA.String(): "deployed",
+		attachmentZ.String(): "deployed",
+	}, graph.states())
+
+	assert.Equal(t, `graph "network" {
+  "00000000-0000-0000-0000-000000000001" [label="aws\ncloud FR5\ndeployed", shape=box];
+  "00000000-0000-0000-0000-000000000002" [label="dc\naccess LD5\ndeployed", shape=box];
+  "00000000-0000-0000-0000-000000000003" [label="fr5-ld5\ntransport FR5\ncreation_pending", shape=ellipse];
+  "00000000-0000-0000-0000-000000000001" -- "00000000-0000-0000-0000-000000000003" [label="A"];
+  "00000000-0000-0000-0000-000000000002" -- "00000000-0000-0000-0000-000000000003" [label="Z"];
+}
+`, graph.dot("network"))
+
+	graphJSON, err := graph.json()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+  "vertices": [
+    {"id": "00000000-0000-0000-0000-000000000001", "kind": "node", "type": "cloud", "name": "aws", "administrative_state": "deployed", "location": "FR5"},
+    {"id": "00000000-0000-0000-0000-000000000002", "kind": "node", "type": "access", "name": "dc", "administrative_state": "deployed", "location": "LD5"},
+    {"id": "00000000-0000-0000-0000-000000000003", "kind": "transport", "type": "transport", "name": "fr5-ld5", "administrative_state": "creation_pending", "location": "FR5"}
+  ],
+  "edges": [
+    {"id": "00000000-0000-0000-0000-000000000004", "node_id": "00000000-0000-0000-0000-000000000001", "transport_id": "00000000-0000-0000-0000-000000000003", "side": "A", "administrative_state": "deployed"},
+    {"id": "00000000-0000-0000-0000-000000000005", "node_id": "00000000-0000-0000-0000-000000000002", "transport_id": "00000000-0000-0000-0000-000000000003", "side": "Z", "administrative_state": "deployed"}
+  ]
+}`, graphJSON)
+}
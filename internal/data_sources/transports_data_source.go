@@ -0,0 +1,224 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+// transportFilters are the fields the transports can be filtered on.
+var transportFilters = []string{"id", "name", "administrative_state", "sku", "location", "bandwidth"}
+
+type transportsDataSource struct {
+	client *autonomisdk.Client
+}
+
+type transportVlans struct {
+	AVlan types.Int64 `tfsdk:"a_vlan"`
+	ZVlan types.Int64 `tfsdk:"z_vlan"`
+}
+
+type transportHit struct {
+	ID           types.String      `tfsdk:"id"`
+	WorkspaceID  types.String      `tfsdk:"workspace_id"`
+	CreatedAt    timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt    timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt   timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name         types.String      `tfsdk:"name"`
+	State        types.String      `tfsdk:"administrative_state"`
+	Product      elementProduct    `tfsdk:"product"`
+	Vlans        transportVlans    `tfsdk:"vlans"`
+	ConnectionID types.String      `tfsdk:"connection_id"`
+}
+
+type transportsDataSourceModel struct {
+	WorkspaceID types.String     `tfsdk:"workspace_id"`
+	Filters     []filters.Filter `tfsdk:"filters"`
+	Transports  []transportHit   `tfsdk:"transports"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &transportsDataSource{}
+	_ datasource.DataSourceWithConfigure = &transportsDataSource{}
+)
+
+func NewTransportsDataSource() datasource.DataSource {
+	return &transportsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *transportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transports"
+}
+
+// Schema defines the schema for the data source.
+func (d *transportsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Datasource to retrieve the list of the transports of a workspace by filters.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"filters":      filters.AutonomiAttribute(transportFilters...),
+			"transports": schema.ListNestedAttribute{
+				MarkdownDescription: "The **transports** of the workspace matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The **ID** of the transport.",
+							Computed:            true,
+						},
+						"workspace_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the workspace to which the transport belongs.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Creation date of the transport",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Update date of the transport",
+							Computed:            true,
+						},
+						"deployed_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Deployment date of the transport, null until it is deployed",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The **name** of the transport.",
+							Computed:            true,
+						},
+						"administrative_state": schema.StringAttribute{
+							MarkdownDescription: `Administrative state of the transport [creation_pending, creation_proceed, creation_error,
+deployed, delete_pending, delete_proceed, delete_error]`,
+							Computed: true,
+						},
+						"product": elementProductAttribute(),
+						"vlans": schema.SingleNestedAttribute{
+							MarkdownDescription: "Vlans of the transport",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"a_vlan": schema.Int64Attribute{
+									MarkdownDescription: "VLAN of the A side of the transport",
+									Computed:            true,
+								},
+								"z_vlan": schema.Int64Attribute{
+									MarkdownDescription: "VLAN of the Z side of the transport",
+									Computed:            true,
+								},
+							},
+						},
+						"connection_id": schema.StringAttribute{
+							MarkdownDescription: "Connection ID of the transport",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func transportHitFromAPI(transport autonomisdkmodel.Transport) transportHit {
+	return transportHit{
+		ID:          types.StringValue(transport.ID.String()),
+		WorkspaceID: types.StringValue(transport.WorkspaceID.String()),
+		CreatedAt:   timestampValue(transport.CreatedAt),
+		UpdatedAt:   timestampValue(transport.UpdatedAt),
+		DeployedAt:  timestampValue(transport.DeployedAt),
+		Name:        types.StringValue(transport.Name),
+		State:       types.StringValue(transport.State.String()),
+		Product:     elementProductFromAPI(transport.Product),
+		Vlans: transportVlans{
+			AVlan: types.Int64Value(transport.TransportVlans.AVlan),
+			ZVlan: types.Int64Value(transport.TransportVlans.ZVlan),
+		},
+		ConnectionID: stringValue(transport.ConnectionID),
+	}
+}
+
+// transportField returns the value of the field of the transport filtered by the filters.
+func transportField(transport *autonomisdkmodel.Transport, name string) (string, bool) {
+	switch name {
+	case "id":
+		return transport.ID.String(), true
+	case "name":
+		return transport.Name, true
+	case "administrative_state":
+		return transport.State.String(), true
+	case "sku":
+		return transport.Product.SKU, true
+	case "location":
+		return transport.Product.Location, true
+	case "bandwidth":
+		return strconv.Itoa(transport.Product.Bandwidth), true
+	}
+	return "", false
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *transportsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *transportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data transportsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transports, err := d.client.ListTransports(ctx, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Transports",
+			"Could not list the transports of workspace "+data.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	filteredTransports, err := filters.ApplyFields(*transports, data.Filters, transportField)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting filters", err.Error())
+		return
+	}
+
+	data.Transports = []transportHit{}
+	for _, transport := range filteredTransports {
+		data.Transports = append(data.Transports, transportHitFromAPI(transport))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,65 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportField(t *testing.T) {
+	transport := autonomisdkmodel.Transport{
+		BaseModel: autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		Name:      "paris-frankfurt",
+		State:     autonomisdkmodel.AdministrativeStateDeployed,
+		Product:   autonomisdkmodel.Product{SKU: "TRP-PAR-FRA-100", Location: "PAR", Bandwidth: 100},
+	}
+	expect := map[string]string{
+		"id":                   "00000000-0000-0000-0000-000000000001",
+		"name":                 "paris-frankfurt",
+		"administrative_state": autonomisdkmodel.AdministrativeStateDeployed.String(),
+		"sku":                  "TRP-PAR-FRA-100",
+		"location":             "PAR",
+		"bandwidth":            "100",
+	}
+
+	assert.Len(t, transportFilters, len(expect))
+	for _, name := range transportFilters {
+		t.Log(name)
+
+		value, ok := transportField(&transport, name)
+		assert.True(t, ok)
+		assert.Equal(t, expect[name], value)
+	}
+	_, ok := transportField(&transport, "location_to")
+	assert.False(t, ok)
+}
+
+func TestAttachmentField(t *testing.T) {
+	attachment := autonomisdkmodel.Attachment{
+		BaseModel:   autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		State:       autonomisdkmodel.AdministrativeStateDeployed,
+		NodeID:      "00000000-0000-0000-0000-000000000002",
+		TransportID: "00000000-0000-0000-0000-000000000003",
+		Side:        "A",
+	}
+	expect := map[string]string{
+		"id":                   "00000000-0000-0000-0000-000000000001",
+		"node_id":              "00000000-0000-0000-0000-000000000002",
+		"transport_id":         "00000000-0000-0000-0000-000000000003",
+		"side":                 "A",
+		"administrative_state": autonomisdkmodel.AdministrativeStateDeployed.String(),
+	}
+
+	assert.Len(t, attachmentFilters, len(expect))
+	for _, name := range attachmentFilters {
+		t.Log(name)
+
+		value, ok := attachmentField(&attachment, name)
+		assert.True(t, ok)
+		assert.Equal(t, expect[name], value)
+	}
+	_, ok := attachmentField(&attachment, "workspace_id")
+	assert.False(t, ok)
+}
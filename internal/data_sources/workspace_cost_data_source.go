@@ -0,0 +1,304 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/catalog"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+type workspaceCostDataSource struct {
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+}
+
+type costLineModel struct {
+	ElementID   types.String `tfsdk:"element_id"`
+	ElementKind types.String `tfsdk:"element_kind"`
+	ElementType types.String `tfsdk:"element_type"`
+	Name        types.String `tfsdk:"name"`
+	SKU         types.String `tfsdk:"sku"`
+	Location    types.String `tfsdk:"location"`
+	Duration    types.Int64  `tfsdk:"duration"`
+	PriceMRC    types.Int64  `tfsdk:"price_mrc"`
+	PriceNRC    types.Int64  `tfsdk:"price_nrc"`
+	CostMRC     types.Int64  `tfsdk:"cost_mrc"`
+	CostNRC     types.Int64  `tfsdk:"cost_nrc"`
+	Source      types.String `tfsdk:"source"`
+}
+
+type costTotalsModel struct {
+	PriceMRC types.Int64 `tfsdk:"price_mrc"`
+	PriceNRC types.Int64 `tfsdk:"price_nrc"`
+	CostMRC  types.Int64 `tfsdk:"cost_mrc"`
+	CostNRC  types.Int64 `tfsdk:"cost_nrc"`
+}
+
+type workspaceCostDataSourceModel struct {
+	WorkspaceID types.String               `tfsdk:"workspace_id"`
+	Lines       []costLineModel            `tfsdk:"lines"`
+	Total       costTotalsModel            `tfsdk:"total"`
+	ByType      map[string]costTotalsModel `tfsdk:"totals_by_type"`
+	ByLocation  map[string]costTotalsModel `tfsdk:"totals_by_location"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceCostDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceCostDataSource{}
+)
+
+func NewWorkspaceCostDataSource() datasource.DataSource {
+	return &workspaceCostDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceCostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_cost"
+}
+
+// costTotalsAttributes returns the attributes of the totals of a cost report.
+func costTotalsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"price_mrc": schema.Int64Attribute{
+			MarkdownDescription: "Total **monthly recurring price** (MRC)",
+			Computed:            true,
+		},
+		"price_nrc": schema.Int64Attribute{
+			MarkdownDescription: "Total **non-recurring price** (NRC)",
+			Computed:            true,
+		},
+		"cost_mrc": schema.Int64Attribute{
+			MarkdownDescription: "Total internal **monthly recurring cost** (MRC)",
+			Computed:            true,
+		},
+		"cost_nrc": schema.Int64Attribute{
+			MarkdownDescription: "Total internal **non-recurring cost** (NRC)",
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceCostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to report the cost of the nodes and the transports of a workspace, priced from the catalog.
+The products not listed in the catalog anymore are priced from the workspace elements.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"lines": schema.ListNestedAttribute{
+				MarkdownDescription: "The cost of every node and transport of the workspace, sorted by kind, type and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"element_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the element",
+							Computed:            true,
+						},
+						"element_kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the element [node, transport]",
+							Computed:            true,
+						},
+						"element_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the element [cloud, access, transport]",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the element",
+							Computed:            true,
+						},
+						"sku": schema.StringAttribute{
+							MarkdownDescription: "SKU of the product of the element",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "Location of the product",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "Commitment duration of the product, in months",
+							Computed:            true,
+						},
+						"price_mrc": schema.Int64Attribute{
+							MarkdownDescription: "The **monthly recurring price** (MRC) of the product.",
+							Computed:            true,
+						},
+						"price_nrc": schema.Int64Attribute{
+							MarkdownDescription: "The **non-recurring price** (NRC) of the product.",
+							Computed:            true,
+						},
+						"cost_mrc": schema.Int64Attribute{
+							MarkdownDescription: "The internal **monthly recurring cost** (MRC) of the product.",
+							Computed:            true,
+						},
+						"cost_nrc": schema.Int64Attribute{
+							MarkdownDescription: "The internal **non-recurring cost** (NRC) of the product.",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Where the prices come from [catalog, api]",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total": schema.SingleNestedAttribute{
+				MarkdownDescription: "Totals of the workspace",
+				Computed:            true,
+				Attributes:          costTotalsAttributes(),
+			},
+			"totals_by_type": schema.MapNestedAttribute{
+				MarkdownDescription: "Totals of the workspace by element type [cloud, access, transport]",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: costTotalsAttributes(),
+				},
+			},
+			"totals_by_location": schema.MapNestedAttribute{
+				MarkdownDescription: "Totals of the workspace by product location",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: costTotalsAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceCostDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+	d.catalog = clients.CatalogClient
+}
+
+func costTotalsModelFrom(totals costTotals) costTotalsModel {
+	return costTotalsModel{
+		PriceMRC: types.Int64Value(totals.PriceMRC),
+		PriceNRC: types.Int64Value(totals.PriceNRC),
+		CostMRC:  types.Int64Value(totals.CostMRC),
+		CostNRC:  types.Int64Value(totals.CostNRC),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceCostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceCostDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements, err := listWorkspaceElements(ctx, d.client, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Workspace Elements", err.Error())
+		return
+	}
+
+	catalogProducts, diags := d.catalogProducts(elements)
+	resp.Diagnostics.Append(diags...)
+
+	report := newCostReport(elements.nodes, elements.transports, catalogProducts)
+	data.Lines = make([]costLineModel, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		data.Lines = append(data.Lines, costLineModel{
+			ElementID:   types.StringValue(line.ElementID),
+			ElementKind: types.StringValue(line.ElementKind),
+			ElementType: types.StringValue(line.ElementType),
+			Name:        types.StringValue(line.Name),
+			SKU:         types.StringValue(line.SKU),
+			Location:    types.StringValue(line.Location),
+			Duration:    types.Int64Value(line.Duration),
+			PriceMRC:    types.Int64Value(line.PriceMRC),
+			PriceNRC:    types.Int64Value(line.PriceNRC),
+			CostMRC:     types.Int64Value(line.CostMRC),
+			CostNRC:     types.Int64Value(line.CostNRC),
+			Source:      types.StringValue(line.Source),
+		})
+	}
+	data.Total = costTotalsModelFrom(report.Total)
+	data.ByType = map[string]costTotalsModel{}
+	for elementType, totals := range report.ByType {
+		data.ByType[elementType] = costTotalsModelFrom(totals)
+	}
+	data.ByLocation = map[string]costTotalsModel{}
+	for location, totals := range report.ByLocation {
+		data.ByLocation[location] = costTotalsModelFrom(totals)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// catalogProducts looks the products of the nodes and of the transports up in the catalog, by SKU, in a
+// single request. The products which cannot be read from the catalog are left out, with a warning when the catalog fails.
+func (d *workspaceCostDataSource) catalogProducts(elements workspaceElements) (map[string]models.Product, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var lookups []catalog.SKULookup
+	looked := map[string]bool{}
+	lookup := func(index, sku string) {
+		if !looked[sku] {
+			looked[sku] = true
+			lookups = append(lookups, catalog.SKULookup{Index: index, SKU: sku})
+		}
+	}
+
+	for _, node := range elements.nodes {
+		index := "accessproduct"
+		if node.Type == autonomisdkmodel.NodeTypeCloud {
+			index = "cloudproduct"
+		}
+		lookup(index, node.Product.SKU)
+	}
+	for _, transport := range elements.transports {
+		lookup("transportproduct", transport.Product.SKU)
+	}
+
+	products := map[string]models.Product{}
+	hits, err := catalog.LookupSKUs(d.catalog, lookups, 1)
+	if err != nil {
+		diags.AddWarning("Unable to price the products",
+			"Could not read the products from the catalog, they are priced from the workspace elements: "+err.Error())
+		return products, diags
+	}
+	for i, lookup := range lookups {
+		if len(hits[i]) == 0 {
+			continue
+		}
+		var product models.Product
+		if err := json.Unmarshal(hits[i][0], &product); err != nil {
+			diags.AddWarning("Unable to price the product",
+				"Could not decode the product "+lookup.SKU+" from the catalog, it is priced from the workspace elements: "+err.Error())
+			continue
+		}
+		products[lookup.SKU] = product
+	}
+	return products, diags
+}
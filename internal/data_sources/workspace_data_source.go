@@ -0,0 +1,150 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+type workspaceDataSource struct {
+	client *autonomisdk.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceDataSource{}
+)
+
+func NewWorkspaceDataSource() datasource.DataSource {
+	return &workspaceDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := workspaceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The **ID** of the workspace. Exactly one of **id** and **name** must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact **name** of the workspace. Exactly one of **id** and **name** must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve a single workspace by ID or by name.
+If no workspace, or more than one, has the name, this datasource raises an error.`,
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceHit
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsNull() {
+		workspace, err := d.client.GetWorkspace(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Unable to Read Autonomi Workspace",
+				"Could not read workspace ID "+data.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		data = workspaceHitFromAPI(*workspace)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	workspaces, err := d.client.ListWorkspaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Workspaces",
+			err.Error(),
+		)
+		return
+	}
+
+	workspace, err := workspaceByName(*workspaces, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find the workspace", err.Error())
+		return
+	}
+	data = workspaceHitFromAPI(workspace)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// workspaceByName returns the only workspace with the name, failing when no workspace, or more than one,
+// has it.
+func workspaceByName(workspaces []autonomisdkmodel.Workspace, name string) (autonomisdkmodel.Workspace, error) {
+	var found []autonomisdkmodel.Workspace
+	for _, workspace := range workspaces {
+		if workspace.Name == name {
+			found = append(found, workspace)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return autonomisdkmodel.Workspace{}, fmt.Errorf("no workspace is named %q", name)
+	case 1:
+		return found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, workspace := range found {
+		ids = append(ids, workspace.ID.String())
+	}
+	return autonomisdkmodel.Workspace{}, fmt.Errorf("%d workspaces are named %q: %s. Look the workspace up by id instead",
+		len(found), name, strings.Join(ids, ", "))
+}
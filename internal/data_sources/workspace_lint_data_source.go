@@ -0,0 +1,255 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/catalog"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+type workspaceLintDataSource struct {
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+}
+
+type lintFindingModel struct {
+	Severity    types.String `tfsdk:"severity"`
+	Rule        types.String `tfsdk:"rule"`
+	ElementID   types.String `tfsdk:"element_id"`
+	ElementKind types.String `tfsdk:"element_kind"`
+	ElementName types.String `tfsdk:"element_name"`
+	Message     types.String `tfsdk:"message"`
+}
+
+type workspaceLintDataSourceModel struct {
+	WorkspaceID  types.String       `tfsdk:"workspace_id"`
+	Findings     []lintFindingModel `tfsdk:"findings"`
+	ErrorCount   types.Int64        `tfsdk:"error_count"`
+	WarningCount types.Int64        `tfsdk:"warning_count"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceLintDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceLintDataSource{}
+)
+
+func NewWorkspaceLintDataSource() datasource.DataSource {
+	return &workspaceLintDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceLintDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_lint"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceLintDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to inspect the elements of a workspace, managed by Terraform or not, and report their issues:
+- **unattached_transport** (warning): transport attached to no node
+- **half_attached_transport** (warning): transport attached to a node on one side only
+- **unattached_node** (warning): node attached to no transport
+- **pending_state** (warning): element whose creation or deletion is pending
+- **error_state** (error): element whose creation or deletion failed
+- **access_node_port_location** (error): access node whose product is not in the location of its physical port
+- **attachment_location** (error): attachment of a node located at none of the ends of the transport
+
+Combined with a ` + "`check`" + ` block, it continuously validates the workspace.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"findings": schema.ListNestedAttribute{
+				MarkdownDescription: "The issues found in the workspace, the errors first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Severity of the issue [error, warning]",
+							Computed:            true,
+						},
+						"rule": schema.StringAttribute{
+							MarkdownDescription: "Rule reporting the issue",
+							Computed:            true,
+						},
+						"element_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the element with the issue",
+							Computed:            true,
+						},
+						"element_kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the element with the issue [node, transport, attachment]",
+							Computed:            true,
+						},
+						"element_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the element with the issue, null for the attachments",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Description of the issue",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of issues of **error** severity",
+				Computed:            true,
+			},
+			"warning_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of issues of **warning** severity",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceLintDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+	d.catalog = clients.CatalogClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceLintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceLintDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements, err := listWorkspaceElements(ctx, d.client, data.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Workspace Elements", err.Error())
+		return
+	}
+
+	portLocations, diags := d.portLocations(ctx, elements.nodes)
+	resp.Diagnostics.Append(diags...)
+	transportProducts, diags := d.transportProducts(elements.transports)
+	resp.Diagnostics.Append(diags...)
+
+	data.Findings = []lintFindingModel{}
+	var errorCount, warningCount int64
+	for _, finding := range lintWorkspace(elements, portLocations, transportProducts) {
+		data.Findings = append(data.Findings, lintFindingModel{
+			Severity:    types.StringValue(finding.Severity),
+			Rule:        types.StringValue(finding.Rule),
+			ElementID:   types.StringValue(finding.ElementID),
+			ElementKind: types.StringValue(finding.ElementKind),
+			ElementName: stringValue(finding.ElementName),
+			Message:     types.StringValue(finding.Message),
+		})
+		switch finding.Severity {
+		case lintSeverityError:
+			errorCount++
+		case lintSeverityWarning:
+			warningCount++
+		}
+	}
+	data.ErrorCount = types.Int64Value(errorCount)
+	data.WarningCount = types.Int64Value(warningCount)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// portLocations returns the location of the physical ports of the access nodes, by ID. The ports which
+// cannot be read are left out with a warning.
+func (d *workspaceLintDataSource) portLocations(ctx context.Context, nodes []autonomisdkmodel.Node) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	locations := map[string]string{}
+	for _, node := range nodes {
+		portID := node.PhysicalPort.ID
+		if node.Type != autonomisdkmodel.NodeTypeAccess || portID == uuid.Nil {
+			continue
+		}
+		if _, ok := locations[portID.String()]; ok {
+			continue
+		}
+		if node.PhysicalPort.Product.Location != "" {
+			locations[portID.String()] = node.PhysicalPort.Product.Location
+			continue
+		}
+
+		port, err := d.client.GetPhysicalPort(ctx, portID.String())
+		if err != nil {
+			diags.AddWarning("Unable to check the physical port",
+				"Could not read physical port "+portID.String()+" of access node "+node.Name+": "+err.Error())
+			continue
+		}
+		locations[portID.String()] = port.Product.Location
+	}
+	return locations, diags
+}
+
+// transportProducts returns the ends of the products of the transports, by transport ID, looked up in the
+// catalog in a single request. The products which cannot be read from the catalog are left out, with a
+// warning when the catalog fails.
+func (d *workspaceLintDataSource) transportProducts(transports []autonomisdkmodel.Transport) (map[string]transportEnds, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var lookups []catalog.SKULookup
+	looked := map[string]bool{}
+	for _, transport := range transports {
+		sku := transport.Product.SKU
+		if !looked[sku] {
+			looked[sku] = true
+			lookups = append(lookups, catalog.SKULookup{Index: "transportproduct", SKU: sku})
+		}
+	}
+
+	products := map[string]transportEnds{}
+	hits, err := catalog.LookupSKUs(d.catalog, lookups, 1)
+	if err != nil {
+		diags.AddWarning("Unable to check the transport products",
+			"Could not read the transport products from the catalog: "+err.Error())
+		return products, diags
+	}
+
+	bySKU := map[string]transportEnds{}
+	for i, lookup := range lookups {
+		if len(hits[i]) == 0 {
+			continue
+		}
+		var product models.TransportProduct
+		if err := json.Unmarshal(hits[i][0], &product); err != nil {
+			diags.AddWarning("Unable to check the transport product",
+				"Could not decode the product "+lookup.SKU+" from the catalog: "+err.Error())
+			continue
+		}
+		bySKU[lookup.SKU] = transportEnds{location: product.Location, locationTo: product.LocationTo}
+	}
+	for _, transport := range transports {
+		if ends, ok := bySKU[transport.Product.SKU]; ok {
+			products[transport.ID.String()] = ends
+		}
+	}
+	return products, diags
+}
@@ -0,0 +1,223 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+type workspaceTopologyDataSource struct {
+	client *autonomisdk.Client
+}
+
+type topologyVertexModel struct {
+	ID       types.String `tfsdk:"id"`
+	Kind     types.String `tfsdk:"kind"`
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	State    types.String `tfsdk:"administrative_state"`
+	Location types.String `tfsdk:"location"`
+}
+
+type topologyEdgeModel struct {
+	ID          types.String `tfsdk:"id"`
+	NodeID      types.String `tfsdk:"node_id"`
+	TransportID types.String `tfsdk:"transport_id"`
+	Side        types.String `tfsdk:"side"`
+	State       types.String `tfsdk:"administrative_state"`
+}
+
+type workspaceTopologyDataSourceModel struct {
+	WorkspaceID types.String          `tfsdk:"workspace_id"`
+	Vertices    []topologyVertexModel `tfsdk:"vertices"`
+	Edges       []topologyEdgeModel   `tfsdk:"edges"`
+	States      map[string]string     `tfsdk:"states"`
+	DOT         types.String          `tfsdk:"dot"`
+	JSON        types.String          `tfsdk:"json"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceTopologyDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceTopologyDataSource{}
+)
+
+func NewWorkspaceTopologyDataSource() datasource.DataSource {
+	return &workspaceTopologyDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceTopologyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_topology"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceTopologyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Datasource to retrieve the graph of a workspace: its nodes and transports are the vertices, linked by
+its attachments. The vertices and the edges are sorted, so the renderings of the graph only change with the workspace.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDAttribute(),
+			"vertices": schema.ListNestedAttribute{
+				MarkdownDescription: "The nodes and the transports of the workspace, sorted by kind and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the element",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the element [node, transport]",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the element [cloud, access, transport]",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the element",
+							Computed:            true,
+						},
+						"administrative_state": schema.StringAttribute{
+							MarkdownDescription: "Administrative state of the element",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "Location of the product of the element",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				MarkdownDescription: "The attachments of the workspace, sorted by transport and side.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the attachment",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the node attached to the transport",
+							Computed:            true,
+						},
+						"transport_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the transport attached to the node",
+							Computed:            true,
+						},
+						"side": schema.StringAttribute{
+							MarkdownDescription: "Side of the transport the node is attached to [A, Z]",
+							Computed:            true,
+						},
+						"administrative_state": schema.StringAttribute{
+							MarkdownDescription: "Administrative state of the attachment",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"states": schema.MapAttribute{
+				MarkdownDescription: "Administrative state of every node, transport and attachment of the workspace, by ID.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"dot": schema.StringAttribute{
+				MarkdownDescription: "The graph rendered in the Graphviz DOT language.",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The graph rendered in JSON, with its `vertices` and `edges`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceTopologyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceTopologyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceTopologyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+	workspace, err := d.client.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Workspace",
+			"Could not read workspace ID "+workspaceID+": "+err.Error(),
+		)
+		return
+	}
+	elements, err := listWorkspaceElements(ctx, d.client, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Autonomi Workspace Elements", err.Error())
+		return
+	}
+
+	graph := newTopology(elements.nodes, elements.transports, elements.attachments)
+	graphJSON, err := graph.json()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Render the Workspace Topology", err.Error())
+		return
+	}
+
+	data.Vertices = make([]topologyVertexModel, 0, len(graph.Vertices))
+	for _, vertex := range graph.Vertices {
+		data.Vertices = append(data.Vertices, topologyVertexModel{
+			ID:       types.StringValue(vertex.ID),
+			Kind:     types.StringValue(vertex.Kind),
+			Type:     types.StringValue(vertex.Type),
+			Name:     types.StringValue(vertex.Name),
+			State:    types.StringValue(vertex.State),
+			Location: types.StringValue(vertex.Location),
+		})
+	}
+	data.Edges = make([]topologyEdgeModel, 0, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		data.Edges = append(data.Edges, topologyEdgeModel{
+			ID:          types.StringValue(edge.ID),
+			NodeID:      types.StringValue(edge.NodeID),
+			TransportID: types.StringValue(edge.TransportID),
+			Side:        types.StringValue(edge.Side),
+			State:       types.StringValue(edge.State),
+		})
+	}
+	data.States = graph.states()
+	data.DOT = types.StringValue(graph.dot(workspace.Name))
+	data.JSON = types.StringValue(graphJSON)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,179 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/data_sources/filters"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+)
+
+// workspaceFilters are the fields the workspaces can be filtered on.
+var workspaceFilters = []string{"id", "name", "description"}
+
+type workspacesDataSource struct {
+	client *autonomisdk.Client
+}
+
+type workspaceHit struct {
+	ID          types.String      `tfsdk:"id"`
+	CreatedAt   timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt   timetypes.RFC3339 `tfsdk:"updated_at"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	AccountID   types.String      `tfsdk:"account_id"`
+}
+
+type workspacesDataSourceModel struct {
+	Filters    []filters.Filter `tfsdk:"filters"`
+	Workspaces []workspaceHit   `tfsdk:"workspaces"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspacesDataSource{}
+)
+
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &workspacesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Datasource to retrieve the list of the workspaces of the account by filters.",
+		Attributes: map[string]schema.Attribute{
+			"filters": filters.AutonomiAttribute(workspaceFilters...),
+			"workspaces": schema.ListNestedAttribute{
+				MarkdownDescription: "The **workspaces** of the account matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workspaceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// workspaceAttributes returns the attributes of a workspace.
+func workspaceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The **ID** of the workspace.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Creation date of the workspace",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Update date of the workspace",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The **name** of the workspace.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The **description** of the workspace.",
+			Computed:            true,
+		},
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "The **account ID** the workspace belongs to.",
+			Computed:            true,
+		},
+	}
+}
+
+// workspaceField returns the value of the field of the workspace filtered by the filters.
+func workspaceField(workspace *autonomisdkmodel.Workspace, name string) (string, bool) {
+	switch name {
+	case "id":
+		return workspace.ID.String(), true
+	case "name":
+		return workspace.Name, true
+	case "description":
+		return workspace.Description, true
+	}
+	return "", false
+}
+
+func workspaceHitFromAPI(workspace autonomisdkmodel.Workspace) workspaceHit {
+	return workspaceHit{
+		ID:          types.StringValue(workspace.ID.String()),
+		CreatedAt:   timestampValue(workspace.CreatedAt),
+		UpdatedAt:   timestampValue(workspace.UpdatedAt),
+		Name:        types.StringValue(workspace.Name),
+		Description: types.StringValue(workspace.Description),
+		AccountID:   types.StringValue(workspace.AccountID),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerdata.Clients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AutonomiClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaces, err := d.client.ListWorkspaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Autonomi Workspaces",
+			err.Error(),
+		)
+		return
+	}
+
+	filteredWorkspaces, err := filters.ApplyFields(*workspaces, data.Filters, workspaceField)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting filters", err.Error())
+		return
+	}
+
+	data.Workspaces = []workspaceHit{}
+	for _, workspace := range filteredWorkspaces {
+		data.Workspaces = append(data.Workspaces, workspaceHitFromAPI(workspace))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
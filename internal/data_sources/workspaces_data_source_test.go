@@ -0,0 +1,87 @@
+package datasources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	autonomisdkmodel "github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceField(t *testing.T) {
+	workspace := autonomisdkmodel.Workspace{
+		BaseModel:   autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		Name:        "network",
+		Description: "shared",
+	}
+	expect := map[string]string{
+		"id":          "00000000-0000-0000-0000-000000000001",
+		"name":        "network",
+		"description": "shared",
+	}
+
+	assert.Len(t, workspaceFilters, len(expect))
+	for _, name := range workspaceFilters {
+		t.Log(name)
+
+		value, ok := workspaceField(&workspace, name)
+		assert.True(t, ok)
+		assert.Equal(t, expect[name], value)
+	}
+	_, ok := workspaceField(&workspace, "account_id")
+	assert.False(t, ok)
+}
+
+func TestWorkspaceByName(t *testing.T) {
+	network := autonomisdkmodel.Workspace{
+		BaseModel: autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		Name:      "network",
+	}
+	lab := autonomisdkmodel.Workspace{
+		BaseModel: autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+		Name:      "lab",
+	}
+	otherLab := autonomisdkmodel.Workspace{
+		BaseModel: autonomisdkmodel.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+		Name:      "lab",
+	}
+	workspaces := []autonomisdkmodel.Workspace{network, lab, otherLab}
+
+	tests := []struct {
+		name      string
+		workspace string
+		expect    autonomisdkmodel.Workspace
+		err       error
+	}{
+		{
+			name:      "single workspace",
+			workspace: "network",
+			expect:    network,
+		},
+		{
+			name:      "unknown workspace",
+			workspace: "prod",
+			err:       errors.New(`no workspace is named "prod"`),
+		},
+		{
+			name:      "workspaces sharing their name",
+			workspace: "lab",
+			err: errors.New(`2 workspaces are named "lab": 00000000-0000-0000-0000-000000000002, ` +
+				`00000000-0000-0000-0000-000000000003. Look the workspace up by id instead`),
+		},
+		{
+			name:      "names are case sensitive",
+			workspace: "Network",
+			err:       errors.New(`no workspace is named "Network"`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		workspace, err := workspaceByName(workspaces, tc.workspace)
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.expect, workspace)
+	}
+}
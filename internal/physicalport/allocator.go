@@ -0,0 +1,178 @@
+// Package physicalport allocates the VLANs and the bandwidth of the access nodes on their physical port,
+// serializing the creations on a port so two access nodes are never given the same VLAN.
+package physicalport
+
+import "sync"
+
+const (
+	// MinVLAN and MaxVLAN bound the usable 802.1Q VLAN IDs, 0 and 4095 being reserved.
+	MinVLAN = 1
+	MaxVLAN = 4094
+)
+
+// Allocator keeps track of the VLANs and the bandwidth allocated and released on the physical ports
+// during a Terraform run.
+// It is shared by the resources through the provider data.
+type Allocator struct {
+	mu    sync.Mutex
+	ports map[string]*port
+}
+
+// plannedNode is the bandwidth of an access node planned on a physical port.
+type plannedNode struct {
+	// key identifies the planned access node until it is created, several access nodes sharing their
+	// key being counted apart
+	key       string
+	bandwidth int64
+}
+
+type port struct {
+	// lock serializes the creations on the port
+	lock sync.Mutex
+	// allocated are the VLANs given to the access nodes created during the run
+	allocated map[int64]bool
+	// released are the VLANs of the access nodes deleted during the run
+	released map[int64]bool
+	// reserved are the VLANs set on the access nodes planned on the port during the run and not created
+	// yet, with the key of their access node
+	reserved map[int64]string
+	// planned is the bandwidth of the access nodes planned on the port during the run and not created yet
+	planned []plannedNode
+}
+
+// NewAllocator returns an allocator without any VLAN allocated or released.
+func NewAllocator() *Allocator {
+	return &Allocator{
+		ports: map[string]*port{},
+	}
+}
+
+func (a *Allocator) port(portID string) *port {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.ports[portID] == nil {
+		a.ports[portID] = &port{
+			allocated: map[int64]bool{},
+			released:  map[int64]bool{},
+			reserved:  map[int64]string{},
+		}
+	}
+	return a.ports[portID]
+}
+
+// Lock locks the physical port until the returned function is called, to create an access node on it.
+func (a *Allocator) Lock(portID string) (unlock func()) {
+	p := a.port(portID)
+	p.lock.Lock()
+	return p.lock.Unlock
+}
+
+// Release records the VLAN as released on the physical port, once its access node is deleted. Until then,
+// the VLAN and the bandwidth of an access node planned to be replaced or destroyed are still in use, the
+// port reporting its bandwidth as available once it is deleted.
+func (a *Allocator) Release(portID string, vlan int64) {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p.released[vlan] = true
+	delete(p.allocated, vlan)
+}
+
+// Allocate records the VLAN as given to an access node of the physical port.
+func (a *Allocator) Allocate(portID string, vlan int64) {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p.allocated[vlan] = true
+	delete(p.released, vlan)
+}
+
+// PlanVlan records the VLAN set on the access node planned on the physical port, so it is not allocated to
+// another access node. False is returned when the VLAN is used on the port, given the VLANs the port
+// reports as used, or set on another access node planned during the run.
+// The VLAN is reserved until Created is called with the same key.
+func (a *Allocator) PlanVlan(portID, key string, used []int64, vlan int64) bool {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if p.used(used, vlan) {
+		return false
+	}
+	if owner, reserved := p.reserved[vlan]; reserved && owner != key {
+		return false
+	}
+	p.reserved[vlan] = key
+	return true
+}
+
+func (p *port) used(used []int64, vlan int64) bool {
+	if p.allocated[vlan] {
+		return true
+	}
+	if p.released[vlan] {
+		return false
+	}
+	for _, u := range used {
+		if u == vlan {
+			return true
+		}
+	}
+	return false
+}
+
+// Lowest returns the lowest VLAN of the [from, to] range free on the physical port, given the VLANs the
+// port reports as used, false being returned when they are all used. The VLANs set on the access nodes
+// planned during the run are skipped.
+func (a *Allocator) Lowest(portID string, used []int64, from, to int64) (int64, bool) {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for vlan := from; vlan <= to; vlan++ {
+		if _, reserved := p.reserved[vlan]; !reserved && !p.used(used, vlan) {
+			return vlan, true
+		}
+	}
+	return 0, false
+}
+
+// PlanBandwidth records the bandwidth of the access node planned on the physical port, and returns the
+// bandwidth left on the port once the access nodes planned during the run are created, given the
+// bandwidth the port reports as available. It is negative when the port would be oversubscribed.
+// The access node is counted until Created is called with the same key.
+func (a *Allocator) PlanBandwidth(portID, key string, bandwidth, available int64) int64 {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p.planned = append(p.planned, plannedNode{key: key, bandwidth: bandwidth})
+	left := available
+	for _, planned := range p.planned {
+		left -= planned.bandwidth
+	}
+	return left
+}
+
+// Created forgets the bandwidth and the VLAN of the planned access node once created, the port then
+// reporting them as used so they are not counted twice by the access nodes planned afterwards.
+func (a *Allocator) Created(portID, key string) {
+	p := a.port(portID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for vlan, owner := range p.reserved {
+		if owner == key {
+			delete(p.reserved, vlan)
+		}
+	}
+	for i, planned := range p.planned {
+		if planned.key == key {
+			p.planned = append(p.planned[:i], p.planned[i+1:]...)
+			return
+		}
+	}
+}
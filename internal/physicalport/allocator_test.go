@@ -0,0 +1,177 @@
+package physicalport
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocatorLowest(t *testing.T) {
+	tests := []struct {
+		name      string
+		used      []int64
+		allocated []int64
+		released  []int64
+		reserved  []int64
+		from      int64
+		to        int64
+		expect    int64
+		found     bool
+	}{
+		{
+			name:   "free port",
+			from:   1,
+			to:     4094,
+			expect: 1,
+			found:  true,
+		},
+		{
+			name:   "lowest free VLAN",
+			used:   []int64{1, 2, 4},
+			from:   1,
+			to:     4094,
+			expect: 3,
+			found:  true,
+		},
+		{
+			name:   "within the allowed range",
+			used:   []int64{100},
+			from:   100,
+			to:     199,
+			expect: 101,
+			found:  true,
+		},
+		{
+			name:      "VLAN allocated during the run",
+			used:      []int64{100},
+			allocated: []int64{101},
+			from:      100,
+			to:        199,
+			expect:    102,
+			found:     true,
+		},
+		{
+			name:     "VLAN released during the run",
+			used:     []int64{100, 101},
+			released: []int64{100},
+			from:     100,
+			to:       199,
+			expect:   100,
+			found:    true,
+		},
+		{
+			name:     "VLAN set on an access node planned during the run",
+			used:     []int64{100},
+			reserved: []int64{101},
+			from:     100,
+			to:       199,
+			expect:   102,
+			found:    true,
+		},
+		{
+			name: "no free VLAN",
+			used: []int64{10, 11},
+			from: 10,
+			to:   11,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		allocator := NewAllocator()
+		for _, vlan := range tc.allocated {
+			allocator.Allocate("port", vlan)
+		}
+		for _, vlan := range tc.released {
+			allocator.Release("port", vlan)
+		}
+		for _, vlan := range tc.reserved {
+			allocator.PlanVlan("port", "workspace/planned", nil, vlan)
+		}
+
+		vlan, found := allocator.Lowest("port", tc.used, tc.from, tc.to)
+		assert.Equal(t, tc.found, found)
+		assert.Equal(t, tc.expect, vlan)
+	}
+}
+
+func TestAllocatorPlanVlan(t *testing.T) {
+	allocator := NewAllocator()
+	used := []int64{100}
+
+	assert.False(t, allocator.PlanVlan("port", "workspace/a", used, 100))
+	assert.True(t, allocator.PlanVlan("port", "workspace/a", used, 101))
+	// the access node is planned again during the apply
+	assert.True(t, allocator.PlanVlan("port", "workspace/a", used, 101))
+	// two access nodes cannot be planned with the same VLAN
+	assert.False(t, allocator.PlanVlan("port", "workspace/b", used, 101))
+	assert.True(t, allocator.PlanVlan("other-port", "workspace/b", used, 101))
+
+	// once created, the VLAN is allocated to the access node
+	allocator.Allocate("port", 101)
+	allocator.Created("port", "workspace/a")
+	assert.False(t, allocator.PlanVlan("port", "workspace/b", used, 101))
+	vlan, _ := allocator.Lowest("port", used, 100, 199)
+	assert.Equal(t, int64(102), vlan)
+}
+
+func TestAllocatorLock(t *testing.T) {
+	allocator := NewAllocator()
+
+	// access nodes created in parallel on the same port are given distinct VLANs
+	var wg sync.WaitGroup
+	vlans := make(chan int64, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := allocator.Lock("port")
+			defer unlock()
+
+			vlan, _ := allocator.Lowest("port", []int64{1}, 1, 4094)
+			allocator.Allocate("port", vlan)
+			vlans <- vlan
+		}()
+	}
+	wg.Wait()
+	close(vlans)
+
+	seen := map[int64]bool{}
+	for vlan := range vlans {
+		assert.False(t, seen[vlan])
+		seen[vlan] = true
+	}
+	assert.Len(t, seen, 10)
+}
+
+func TestAllocatorPlanBandwidth(t *testing.T) {
+	allocator := NewAllocator()
+
+	assert.Equal(t, int64(500), allocator.PlanBandwidth("port", "a", 500, 1000))
+	// access nodes sharing their key are counted apart
+	assert.Equal(t, int64(300), allocator.PlanBandwidth("port", "a", 200, 1000))
+	assert.Equal(t, int64(-700), allocator.PlanBandwidth("port", "b", 1000, 1000))
+	assert.Equal(t, int64(0), allocator.PlanBandwidth("other-port", "c", 1000, 1000))
+
+	// the bandwidth of a deleted access node is only available once the port reports it
+	allocator.Release("port", 100)
+	assert.Equal(t, int64(-1700), allocator.PlanBandwidth("port", "d", 1000, 1000))
+}
+
+func TestAllocatorPlanBandwidthDuringApply(t *testing.T) {
+	allocator := NewAllocator()
+
+	// during the apply, each access node is planned right before its creation
+	assert.Equal(t, int64(600), allocator.PlanBandwidth("port", "workspace/a", 400, 1000))
+	allocator.Created("port", "workspace/a")
+	// the port reports the bandwidth of the created access node as used, it is not counted twice
+	assert.Equal(t, int64(200), allocator.PlanBandwidth("port", "workspace/b", 400, 600))
+	allocator.Created("port", "workspace/b")
+	assert.Equal(t, int64(-100), allocator.PlanBandwidth("port", "workspace/c", 300, 200))
+
+	// forgetting an access node not planned is a no-op
+	allocator.Created("port", "workspace/unknown")
+	assert.Equal(t, int64(-200), allocator.PlanBandwidth("port", "workspace/d", 100, 200))
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAccessNodeResource(t *testing.T) {
+	sku := testAccEnv(t, "AUTONOMI_TEST_ACCESS_SKU")
+	physicalPortID := testAccEnv(t, "AUTONOMI_TEST_PHYSICAL_PORT_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_access_node" "test_access_node" {
+	name = "test_access_node_name"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+	physical_port_id = %q
+	allowed_vlan_range = {
+		from = 100
+		to   = 199
+	}
+}
+`, sku, physicalPortID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_access_node.test_access_node", "name", "test_access_node_name"),
+					resource.TestCheckResourceAttr("autonomi_access_node.test_access_node", "product.sku", sku),
+					resource.TestCheckResourceAttrPair("autonomi_access_node.test_access_node", "workspace_id", "autonomi_workspace.test_workspace", "id"),
+					resource.TestCheckResourceAttr("autonomi_access_node.test_access_node", "physical_port_id", physicalPortID),
+					// check if the ID is set to ensure the resource is created
+					resource.TestCheckResourceAttrSet("autonomi_access_node.test_access_node", "id"),
+					resource.TestCheckResourceAttrSet("autonomi_access_node.test_access_node", "created_at"),
+					resource.TestCheckResourceAttrSet("autonomi_access_node.test_access_node", "updated_at"),
+					resource.TestCheckResourceAttrSet("autonomi_access_node.test_access_node", "deployed_at"),
+					resource.TestCheckResourceAttrSet("autonomi_access_node.test_access_node", "vlan"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// a refresh right after the creation must not plan any change
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Update testing, only the renamed attribute and updated_at are expected in the plan
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_access_node" "test_access_node" {
+	name = "test_access_node_name_updated"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+	physical_port_id = %q
+	allowed_vlan_range = {
+		from = 100
+		to   = 199
+	}
+}
+`, sku, physicalPortID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("autonomi_access_node.test_access_node", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("deployed_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("administrative_state"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("type"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("vlan"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_access_node.test_access_node", tfjsonpath.New("product").AtMapKey("provider"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("autonomi_access_node.test_access_node", tfjsonpath.New("updated_at")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_access_node.test_access_node", "name", "test_access_node_name_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
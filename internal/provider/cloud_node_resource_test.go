@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCloudNodeResource(t *testing.T) {
+	sku := testAccEnv(t, "AUTONOMI_TEST_CLOUD_SKU")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_cloud_node" "test_cloud_node" {
+	name = "test_cloud_node_name"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+	aws {
+		account_id = "123456789012"
+	}
+}
+`, sku),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_cloud_node.test_cloud_node", "name", "test_cloud_node_name"),
+					resource.TestCheckResourceAttr("autonomi_cloud_node.test_cloud_node", "product.sku", sku),
+					resource.TestCheckResourceAttrPair("autonomi_cloud_node.test_cloud_node", "workspace_id", "autonomi_workspace.test_workspace", "id"),
+					// check if the ID is set to ensure the resource is created
+					resource.TestCheckResourceAttrSet("autonomi_cloud_node.test_cloud_node", "id"),
+					resource.TestCheckResourceAttrSet("autonomi_cloud_node.test_cloud_node", "created_at"),
+					resource.TestCheckResourceAttrSet("autonomi_cloud_node.test_cloud_node", "updated_at"),
+					resource.TestCheckResourceAttrSet("autonomi_cloud_node.test_cloud_node", "deployed_at"),
+					resource.TestCheckResourceAttrSet("autonomi_cloud_node.test_cloud_node", "aws.connection_id"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// a refresh right after the creation must not plan any change
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Update testing, only the renamed attribute and updated_at are expected in the plan
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_cloud_node" "test_cloud_node" {
+	name = "test_cloud_node_name_updated"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+	aws {
+		account_id = "123456789012"
+	}
+}
+`, sku),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("autonomi_cloud_node.test_cloud_node", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("deployed_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("administrative_state"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("type"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("aws").AtMapKey("connection_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("vlan"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("product").AtMapKey("provider"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("autonomi_cloud_node.test_cloud_node", tfjsonpath.New("updated_at")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_cloud_node.test_cloud_node", "name", "test_cloud_node_name_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -3,20 +3,26 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	autonomisdk "github.com/intercloud/autonomi-sdk"
-	"github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
 	datasources "github.com/intercloud/terraform-provider-autonomi/internal/data_sources"
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 	autonomiresource "github.com/intercloud/terraform-provider-autonomi/internal/resources"
+	"github.com/intercloud/terraform-provider-autonomi/internal/transport"
 	"github.com/meilisearch/meilisearch-go"
 )
 
@@ -34,13 +40,19 @@ type autonomiProvider struct {
 }
 
 type autonomiProviderModel struct {
-	TermsAndConditions types.Bool   `tfsdk:"terms_and_conditions"`
-	PAT                types.String `tfsdk:"personal_access_token"`
+	TermsAndConditions     types.Bool   `tfsdk:"terms_and_conditions"`
+	PAT                    types.String `tfsdk:"personal_access_token"`
+	EnforceCommitmentTerms types.Bool   `tfsdk:"enforce_commitment_terms"`
+	MaxMonthlyCost         types.Int64  `tfsdk:"max_monthly_cost"`
+	PhysicalPortHeadroom   types.Int64  `tfsdk:"physical_port_headroom"`
 }
 
 const (
 	AUTONOMI_HOST_URL    = "https://api.autonomi-platform.com/v1"
 	AUTONOMI_CATALOG_URL = "https://search.autonomi-platform.com"
+
+	// defaultPhysicalPortHeadroom is the share of the bandwidth of the physical ports kept available by default, in percent
+	defaultPhysicalPortHeadroom = 10
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -76,6 +88,24 @@ You must configure the provider with the proper credentials before you can use i
 				Sensitive:           true,
 				Description:         "The Personal Access Token (PAT) used to authenticate with the Autonomi API. This token can be obtained from the Autonomi service and is required to access and manage resources via the API. Can be set as variable or in environment as AUTONOMI_PAT",
 			},
+			"enforce_commitment_terms": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying or replacing a node, a transport or a physical port before the end of the commitment term of its product fails. Defaults to false, such plans raising a warning with the months remaining and the estimated early termination exposure.",
+				Optional:            true,
+			},
+			"max_monthly_cost": schema.Int64Attribute{
+				MarkdownDescription: "Default maximum monthly cost of the workspaces, applying to the workspaces whose `max_monthly_cost` is not set. Plans pushing the monthly recurring price of the elements of a workspace over its budget fail.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"physical_port_headroom": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Share of the bandwidth of the physical ports to keep available, in percent. Planning an access node leaving its physical port with less bandwidth available raises a warning. Defaults to %d.", defaultPhysicalPortHeadroom),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
 		},
 	}
 }
@@ -186,12 +216,26 @@ func (p *autonomiProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	datasourceClient := models.Clients{CatalogClient: catalogClient, AutonomiClient: client}
+	physicalPortHeadroom := int64(defaultPhysicalPortHeadroom)
+	if !config.PhysicalPortHeadroom.IsNull() {
+		physicalPortHeadroom = config.PhysicalPortHeadroom.ValueInt64()
+	}
+
+	clients := providerdata.Clients{
+		CatalogClient:          catalogClient,
+		AutonomiClient:         client,
+		EnforceCommitmentTerms: config.EnforceCommitmentTerms.ValueBool(),
+		Budget:                 budget.NewLedger(config.MaxMonthlyCost.ValueInt64Pointer()),
+		Prices:                 budget.NewPrices(catalogClient),
+		PhysicalPorts:          physicalport.NewAllocator(),
+		PhysicalPortHeadroom:   physicalPortHeadroom,
+		TransportSides:         transport.NewSides(),
+	}
 
 	// Make the Autonomi client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = datasourceClient
-	resp.ResourceData = client
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -209,6 +253,19 @@ func (p *autonomiProvider) DataSources(_ context.Context) []func() datasource.Da
 		datasources.NewPhysicalPortDataSource,
 		datasources.NewPhysicalPortProductDataSource,
 		datasources.NewPhysicalPortProductsDataSource,
+		datasources.NewWorkspaceDataSource,
+		datasources.NewWorkspacesDataSource,
+		datasources.NewNodeDataSource,
+		datasources.NewNodesDataSource,
+		datasources.NewTransportsDataSource,
+		datasources.NewAttachmentsDataSource,
+		datasources.NewWorkspaceTopologyDataSource,
+		datasources.NewWorkspaceLintDataSource,
+		datasources.NewWorkspaceCostDataSource,
+		datasources.NewPhysicalPortVlansDataSource,
+		datasources.NewProductDataSource,
+		datasources.NewCatalogSearchDataSource,
+		datasources.NewLocationsDataSource,
 	}
 }
 
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"os"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -28,3 +31,18 @@ var (
 		"autonomi": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccEnv returns the value of the environment variable, skipping the test when it is not set. The
+// acceptance tests read the SKUs of the products and the physical port they use from the environment,
+// so they run against products listed in the catalog without ordering a physical port:
+// AUTONOMI_TEST_CLOUD_SKU, AUTONOMI_TEST_TRANSPORT_SKU, AUTONOMI_TEST_ACCESS_SKU and
+// AUTONOMI_TEST_PHYSICAL_PORT_ID.
+func testAccEnv(t *testing.T, name string) string {
+	t.Helper()
+
+	value := os.Getenv(name)
+	if value == "" {
+		t.Skipf("%s must be set to run this acceptance test", name)
+	}
+	return value
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTransportResource(t *testing.T) {
+	sku := testAccEnv(t, "AUTONOMI_TEST_TRANSPORT_SKU")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_transport" "test_transport" {
+	name = "test_transport_name"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+}
+`, sku),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_transport.test_transport", "name", "test_transport_name"),
+					resource.TestCheckResourceAttr("autonomi_transport.test_transport", "product.sku", sku),
+					resource.TestCheckResourceAttrPair("autonomi_transport.test_transport", "workspace_id", "autonomi_workspace.test_workspace", "id"),
+					// check if the ID is set to ensure the resource is created
+					resource.TestCheckResourceAttrSet("autonomi_transport.test_transport", "id"),
+					resource.TestCheckResourceAttrSet("autonomi_transport.test_transport", "created_at"),
+					resource.TestCheckResourceAttrSet("autonomi_transport.test_transport", "updated_at"),
+					resource.TestCheckResourceAttrSet("autonomi_transport.test_transport", "deployed_at"),
+					resource.TestCheckResourceAttrSet("autonomi_transport.test_transport", "connection_id"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// a refresh right after the creation must not plan any change
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Update testing, only the renamed attribute and updated_at are expected in the plan
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name"
+	description = "test_resource_description"
+}
+
+resource "autonomi_transport" "test_transport" {
+	name = "test_transport_name_updated"
+	workspace_id = autonomi_workspace.test_workspace.id
+	product = {
+		sku = %q
+	}
+}
+`, sku),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("autonomi_transport.test_transport", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("deployed_at"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("administrative_state"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("connection_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("vlans"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_transport.test_transport", tfjsonpath.New("product").AtMapKey("provider"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("autonomi_transport.test_transport", tfjsonpath.New("updated_at")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_transport.test_transport", "name", "test_transport_name_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccWorkspaceResource(t *testing.T) {
@@ -27,6 +30,36 @@ resource "autonomi_workspace" "test_workspace" {
 					resource.TestCheckResourceAttrSet("autonomi_workspace.test_workspace", "created_at"),
 					resource.TestCheckResourceAttrSet("autonomi_workspace.test_workspace", "updated_at"),
 				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// a refresh right after the creation must not plan any change
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Update testing, only the renamed attribute and updated_at are expected in the plan
+			{
+				Config: providerConfig + `
+resource "autonomi_workspace" "test_workspace" {
+	name = "test_resource_name_updated"
+	description = "test_resource_description"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("autonomi_workspace.test_workspace", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("autonomi_workspace.test_workspace", tfjsonpath.New("id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_workspace.test_workspace", tfjsonpath.New("account_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("autonomi_workspace.test_workspace", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("autonomi_workspace.test_workspace", tfjsonpath.New("updated_at")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("autonomi_workspace.test_workspace", "name", "test_resource_name_updated"),
+				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
@@ -0,0 +1,30 @@
+// Package providerdata holds the data the provider shares with its resources and data sources once
+// configured.
+package providerdata
+
+import (
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+	"github.com/intercloud/terraform-provider-autonomi/internal/transport"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Clients are the clients and the run-wide state handed to the resources and the data sources.
+type Clients struct {
+	CatalogClient  *meilisearch.Client
+	AutonomiClient *autonomisdk.Client
+	// EnforceCommitmentTerms turns the warnings raised when an element is destroyed or replaced before
+	// the end of its commitment term into errors.
+	EnforceCommitmentTerms bool
+	// Budget records the budget and the elements of the workspaces
+	Budget *budget.Ledger
+	// Prices looks the monthly recurring price of the products up in the catalog
+	Prices *budget.Prices
+	// PhysicalPorts allocates the VLANs and the bandwidth of the access nodes on their physical port
+	PhysicalPorts *physicalport.Allocator
+	// PhysicalPortHeadroom is the share of the bandwidth of the physical ports to keep available, in percent
+	PhysicalPortHeadroom int64
+	// TransportSides records the nodes attached to the sides of the transports
+	TransportSides *transport.Sides
+}
@@ -5,40 +5,85 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 // accessNodeResource is the resource implementation.
 type accessNodeResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	// enforceCommitmentTerms raises commitment term warnings as errors
+	enforceCommitmentTerms bool
+	budget                 *budget.Ledger
+	prices                 *budget.Prices
+	ports                  *physicalport.Allocator
+	// headroom is the share of the bandwidth of physical ports to keep available, in percent
+	headroom int64
 }
 
 type accessNodeResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	WorkspaceID    types.String `tfsdk:"workspace_id"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
-	DeployedAt     types.String `tfsdk:"deployed_at"`
-	Name           types.String `tfsdk:"name"`
-	State          types.String `tfsdk:"administrative_state"`
-	Type           types.String `tfsdk:"type"`
-	Product        product      `tfsdk:"product"`
-	PhysicalPortID types.String `tfsdk:"physical_port_id"`
-	Vlan           types.Int64  `tfsdk:"vlan"`
+	ID                 types.String      `tfsdk:"id"`
+	WorkspaceID        types.String      `tfsdk:"workspace_id"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt          timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt         timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name               types.String      `tfsdk:"name"`
+	State              types.String      `tfsdk:"administrative_state"`
+	Type               types.String      `tfsdk:"type"`
+	Product            product           `tfsdk:"product"`
+	PhysicalPortID     types.String      `tfsdk:"physical_port_id"`
+	Vlan               types.Int64       `tfsdk:"vlan"`
+	AllowedVlanRange   *vlanRange        `tfsdk:"allowed_vlan_range"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+}
+
+// vlanRange is the range of VLANs an access node VLAN is allocated from.
+type vlanRange struct {
+	From types.Int64 `tfsdk:"from"`
+	To   types.Int64 `tfsdk:"to"`
+}
+
+// bounds returns the bounds of the range, every valid VLAN being allowed when the range is not set.
+func (r *vlanRange) bounds() (int64, int64) {
+	if r == nil {
+		return minVlan, maxVlan
+	}
+	return r.From.ValueInt64(), r.To.ValueInt64()
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &accessNodeResource{}
-	_ resource.ResourceWithConfigure = &accessNodeResource{}
+	_ resource.Resource                   = &accessNodeResource{}
+	_ resource.ResourceWithConfigure      = &accessNodeResource{}
+	_ resource.ResourceWithUpgradeState   = &accessNodeResource{}
+	_ resource.ResourceWithModifyPlan     = &accessNodeResource{}
+	_ resource.ResourceWithValidateConfig = &accessNodeResource{}
 )
 
+// accessNodeImmutablePaths lists the attributes that cannot be updated in place.
+var accessNodeImmutablePaths = []path.Path{
+	path.Root("workspace_id"),
+	path.Root("product").AtName("sku"),
+	path.Root("physical_port_id"),
+	path.Root("vlan"),
+}
+
 // NewAccessNodeResource is a helper function to simplify the provider implementation.
 func NewAccessNodeResource() resource.Resource {
 	return &accessNodeResource{}
@@ -52,18 +97,24 @@ func (r *accessNodeResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.enforceCommitmentTerms = clients.EnforceCommitmentTerms
+	r.budget = clients.Budget
+	r.prices = clients.Prices
+	r.ports = clients.PhysicalPorts
+	r.headroom = clients.PhysicalPortHeadroom
 }
 
 // Metadata returns the resource type name.
@@ -74,9 +125,11 @@ func (r *accessNodeResource) Metadata(_ context.Context, req resource.MetadataRe
 // Schema defines the schema for the resource.
 func (r *accessNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages an access node resource.
 Access node resource allows you to create, modify and delete Autonomi access nodes.
-Autonomi access node allows you to easily connect to your datacenters assets via a physical connection (physical access node).`,
+Autonomi access node allows you to easily connect to your datacenters assets via a physical connection (physical access node).
+The creation fails when the access node is not deployed within 30 minutes, the access node being then marked as tainted.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "ID of the access node, set after creation",
@@ -86,55 +139,134 @@ Autonomi access node allows you to easily connect to your datacenters assets via
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the access node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the access node",
 				Computed:            true,
 			},
 			"deployed_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Deployment date of the access node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the workspace to which the access node belongs.",
 				Required:            true,
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the access node",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"physical_port_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the physical port id to which the access node is linked",
 				Required:            true,
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the access node [creation_pending, creation_proceed, creation_error,
 deployed, delete_pending, delete_proceed, delete_error]`,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"product": schema.SingleNestedAttribute{
-				Required: true,
+			"product": productAttribute(),
+			"vlan": schema.Int64Attribute{
+				MarkdownDescription: `Vlan of the access node. It must not be used by another access node of the physical port.
+When not set, the lowest VLAN free on the physical port, within ` + "`allowed_vlan_range`" + ` when set, is allocated on creation.`,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					vlanValidator(),
+				},
+			},
+			"allowed_vlan_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "Range of VLANs the VLAN of the access node is allocated from, when `vlan` is not set",
+				Optional:            true,
 				Attributes: map[string]schema.Attribute{
-					"sku": schema.StringAttribute{
-						MarkdownDescription: "ID of the product",
+					"from": schema.Int64Attribute{
+						MarkdownDescription: "First VLAN of the range",
 						Required:            true,
+						Validators: []validator.Int64{
+							vlanValidator(),
+						},
+					},
+					"to": schema.Int64Attribute{
+						MarkdownDescription: "Last VLAN of the range",
+						Required:            true,
+						Validators: []validator.Int64{
+							vlanValidator(),
+						},
 					},
 				},
-			},
-			"vlan": schema.Int64Attribute{
-				MarkdownDescription: "Vlan of the access node",
-				Required:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("vlan")),
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Type of the node [access]",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"deletion_protection": deletionProtectionAttribute("access node"),
 		},
 	}
 }
 
+// ValidateConfig checks the allowed VLAN range is not empty.
+func (r *accessNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var allowedVlanRange types.Object
+	var bounds *vlanRange
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allowed_vlan_range"), &allowedVlanRange)...)
+	if resp.Diagnostics.HasError() || allowedVlanRange.IsNull() || allowedVlanRange.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(allowedVlanRange.As(ctx, &bounds, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || bounds.From.IsUnknown() || bounds.To.IsUnknown() {
+		return
+	}
+
+	if from, to := bounds.bounds(); from > to {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_vlan_range"),
+			"Invalid VLAN range",
+			fmt.Sprintf("The first VLAN of the range (%d) must not be greater than its last VLAN (%d).", from, to),
+		)
+	}
+}
+
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *accessNodeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at", "deployed_at")
+			setDefault(rawState, "deletion_protection", false)
+		}),
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *accessNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -154,34 +286,37 @@ func (r *accessNodeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Generate API request body from plan
-	payload := models.CreateNode{
-		Name: plan.Name.ValueString(),
-		Type: models.NodeTypeAccess,
-		Product: models.AddProduct{
-			SKU: plan.Product.SKU.ValueString(),
-		},
-		Vlan:           plan.Vlan.ValueInt64(),
-		PhysicalPortID: &parsePhysicalPortID,
+	submitted, err := r.submitNode(ctx, &plan, parsePhysicalPortID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating node",
+			"Could not create node, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
-	// Create new node
-	node, err := r.client.CreateNode(ctx, payload, plan.WorkspaceID.ValueString(), autonomisdk.WithWaitUntilElementDeployed())
+	// Save the submitted node before waiting for its deployment, so Terraform taints it rather than
+	// losing it when it fails to deploy
+	plan.setNode(submitted)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, err := waitUntilNodeDeployed(ctx, r.client, plan.WorkspaceID.ValueString(), submitted.ID.String())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating node",
-			"Could not create node, unexpected error: "+err.Error(),
+			"Could not deploy node "+submitted.ID.String()+", unexpected error: "+err.Error(),
 		)
 		return
 	}
+	// the physical port now reports the bandwidth of the access node as used
+	r.ports.Created(plan.PhysicalPortID.ValueString(), plannedNodeKey(plan.WorkspaceID, plan.Name))
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(node.ID.String())
-	plan.State = types.StringValue(node.State.String())
-	plan.Type = types.StringValue(node.Type.String())
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
+	plan.setNode(node)
 	plan.Vlan = types.Int64Value(node.Vlan)
 	plan.PhysicalPortID = types.StringValue(node.PhysicalPort.ID.String())
 
@@ -193,6 +328,52 @@ func (r *accessNodeResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 }
 
+// setNode sets the computed attributes of the access node created from the node returned by the API.
+func (m *accessNodeResourceModel) setNode(node *models.Node) {
+	m.ID = types.StringValue(node.ID.String())
+	m.State = types.StringValue(node.State.String())
+	m.Type = types.StringValue(node.Type.String())
+	m.CreatedAt = timestampValue(node.CreatedAt)
+	m.UpdatedAt = timestampValue(node.UpdatedAt)
+	m.DeployedAt = timestampValue(node.DeployedAt)
+	m.Product = plannedProduct(m.Product, node.Product)
+}
+
+// submitNode allocates the VLAN of the planned access node when it is not set, and submits its creation
+// without waiting for its deployment. Submissions on the physical port are serialized, so two access
+// nodes are never given the same VLAN, the port being unlocked before the deployment.
+func (r *accessNodeResource) submitNode(ctx context.Context, plan *accessNodeResourceModel, physicalPortID uuid.UUID) (*models.Node, error) {
+	unlock := r.ports.Lock(plan.PhysicalPortID.ValueString())
+	defer unlock()
+
+	if plan.Vlan.IsUnknown() {
+		physicalPort, err := r.client.GetPhysicalPort(ctx, plan.PhysicalPortID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not read physical port %s to allocate a VLAN: %w", plan.PhysicalPortID.ValueString(), err)
+		}
+
+		from, to := plan.AllowedVlanRange.bounds()
+		free, ok := r.ports.Lowest(plan.PhysicalPortID.ValueString(), physicalPort.UsedVLANs, from, to)
+		if !ok {
+			return nil, fmt.Errorf("no VLAN between %d and %d is free on physical port %s", from, to, plan.PhysicalPortID.ValueString())
+		}
+		plan.Vlan = types.Int64Value(free)
+	}
+	r.ports.Allocate(plan.PhysicalPortID.ValueString(), plan.Vlan.ValueInt64())
+
+	// Generate API request body from plan
+	payload := models.CreateNode{
+		Name: plan.Name.ValueString(),
+		Type: models.NodeTypeAccess,
+		Product: models.AddProduct{
+			SKU: plan.Product.SKU.ValueString(),
+		},
+		Vlan:           plan.Vlan.ValueInt64(),
+		PhysicalPortID: &physicalPortID,
+	}
+	return r.client.CreateNode(ctx, payload, plan.WorkspaceID.ValueString())
+}
+
 func (r *accessNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
 	var state accessNodeResourceModel
@@ -214,15 +395,13 @@ func (r *accessNodeResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(node.ID.String())
-	state.CreatedAt = types.StringValue(node.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	state.DeployedAt = types.StringValue(node.DeployedAt.String())
+	state.CreatedAt = timestampValue(node.CreatedAt)
+	state.UpdatedAt = timestampValue(node.UpdatedAt)
+	state.DeployedAt = timestampValue(node.DeployedAt)
 	state.Name = types.StringValue(node.Name)
 	state.State = types.StringValue(node.State.String())
 	state.Type = types.StringValue(node.Type.String())
-	state.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
+	state.Product = productFromAPI(state.Product, node.Product)
 	state.PhysicalPortID = types.StringValue(node.PhysicalPort.ID.String())
 	state.Vlan = types.Int64Value(node.Vlan)
 
@@ -261,14 +440,12 @@ func (r *accessNodeResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update resource state with updated items and timestamp
 	plan.ID = types.StringValue(node.ID.String())
 	plan.Name = types.StringValue(node.Name)
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
+	plan.CreatedAt = timestampValue(node.CreatedAt)
+	plan.UpdatedAt = timestampValue(node.UpdatedAt)
+	plan.DeployedAt = timestampValue(node.DeployedAt)
 	plan.State = types.StringValue(node.State.String())
 	plan.Type = types.StringValue(node.Type.String())
-	plan.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
+	plan.Product = plannedProduct(plan.Product, node.Product)
 	plan.Vlan = types.Int64Value(node.Vlan)
 	plan.PhysicalPortID = types.StringValue(node.PhysicalPort.ID.String())
 
@@ -279,6 +456,139 @@ func (r *accessNodeResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 }
 
+// ModifyPlan prevents the destruction of a protected access node, rejects the changes that cannot be made in place,
+// warns when its commitment term is not over, checks the product is a physical access product, resolving its details, checks its VLAN
+// and its bandwidth fit on the physical port, and keeps its workspace within budget.
+func (r *accessNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "access node")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkImmutable(ctx, req, resp, "access node", accessNodeImmutablePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkCommitmentTerm(ctx, req, resp, "access node", r.enforceCommitmentTerms, path.Root("deployed_at"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkProductSKU(ctx, r.catalog, physicalAccessProductIndex, req, resp, nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkPhysicalPort(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWorkspaceBudget(ctx, r.client, r.budget, r.prices, req, resp, "access node")
+}
+
+// checkPhysicalPort rejects a VLAN already used on the physical port of a new access node, or set on
+// another access node planned on it, and checks a VLAN can be allocated when it is not set. It also rejects a product whose bandwidth would oversubscribe
+// the physical port, along with the other access nodes planned on it, and warns when the port would be
+// left with less than the headroom configured on the provider. The VLAN and the bandwidth of an access
+// node replaced or destroyed are still in use until it is deleted.
+func (r *accessNodeResource) checkPhysicalPort(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || r.ports == nil {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	// the plan holds the product details resolved from the catalog
+	var workspaceID, name, portID types.String
+	var plannedVlan types.Int64
+	var allowedVlanRange types.Object
+	var plannedProduct product
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("physical_port_id"), &portID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("vlan"), &plannedVlan)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("allowed_vlan_range"), &allowedVlanRange)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("product"), &plannedProduct)...)
+	if resp.Diagnostics.HasError() || portID.IsUnknown() || allowedVlanRange.IsUnknown() {
+		return
+	}
+
+	physicalPort, err := r.client.GetPhysicalPort(ctx, portID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("physical_port_id"),
+			"Unable to check the physical port",
+			"Could not read physical port "+portID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if !plannedProduct.Bandwidth.IsNull() && !plannedProduct.Bandwidth.IsUnknown() {
+		r.checkBandwidth(resp, physicalPort, portID.ValueString(), plannedNodeKey(workspaceID, name), plannedProduct.Bandwidth.ValueInt64())
+	}
+
+	if !plannedVlan.IsUnknown() {
+		if !r.ports.PlanVlan(portID.ValueString(), plannedNodeKey(workspaceID, name), physicalPort.UsedVLANs, plannedVlan.ValueInt64()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("vlan"),
+				"VLAN already used",
+				fmt.Sprintf("VLAN %d is already used on physical port %s, or set on another access node planned on it. "+
+					"Choose another VLAN, or do not set vlan so a free one is allocated.", plannedVlan.ValueInt64(), portID.ValueString()),
+			)
+		}
+		return
+	}
+
+	var bounds *vlanRange
+	resp.Diagnostics.Append(allowedVlanRange.As(ctx, &bounds, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	from, to := bounds.bounds()
+	if _, ok := r.ports.Lowest(portID.ValueString(), physicalPort.UsedVLANs, from, to); !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_vlan_range"),
+			"No free VLAN",
+			fmt.Sprintf("No VLAN between %d and %d is free on physical port %s.", from, to, portID.ValueString()),
+		)
+	}
+}
+
+// plannedNodeKey identifies the access node planned for creation on its physical port until it is created,
+// its ID being unknown until then.
+func plannedNodeKey(workspaceID, name types.String) string {
+	return workspaceID.ValueString() + "/" + name.ValueString()
+}
+
+// checkBandwidth plans the bandwidth of the access node on the physical port, raising an error when the
+// port would be oversubscribed, and a warning when it would be left with less than the headroom.
+func (r *accessNodeResource) checkBandwidth(resp *resource.ModifyPlanResponse, physicalPort *models.PhysicalPort, portID, key string, bandwidth int64) {
+	left := r.ports.PlanBandwidth(portID, key, bandwidth, int64(physicalPort.AvailableBandwidth))
+	if left < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("product").AtName("sku"),
+			"Physical port oversubscribed",
+			fmt.Sprintf("The access node needs %d Mbps on physical port %s, which only has %d Mbps available "+
+				"once the other access nodes planned on it are created. Choose a product with a lower bandwidth, "+
+				"or another physical port.", bandwidth, portID, left+bandwidth),
+		)
+		return
+	}
+
+	capacity := int64(physicalPort.Product.Bandwidth)
+	if headroom := capacity * r.headroom / 100; left < headroom {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("product").AtName("sku"),
+			"Low physical port headroom",
+			fmt.Sprintf("Once the access node is created, physical port %s is left with %d Mbps of its %d Mbps, "+
+				"below the %d%% headroom (%d Mbps) configured by physical_port_headroom on the provider.",
+				portID, left, capacity, r.headroom, headroom),
+		)
+	}
+}
+
 func (r *accessNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state accessNodeResourceModel
@@ -288,6 +598,11 @@ func (r *accessNodeResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(deletionProtectionError("access node", state.ID.ValueString()))
+		return
+	}
+
 	// Delete existing node
 	_, err := r.client.DeleteNode(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString(), autonomisdk.WithWaitUntilElementUndeployed())
 	if err != nil {
@@ -297,4 +612,9 @@ func (r *accessNodeResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 		return
 	}
+
+	// the VLAN is free for the access nodes created afterwards during the run
+	if r.ports != nil {
+		r.ports.Release(state.PhysicalPortID.ValueString(), state.Vlan.ValueInt64())
+	}
 }
@@ -0,0 +1,79 @@
+package autonomiresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessNodeCheckBandwidth(t *testing.T) {
+	skuPath := path.Root("product").AtName("sku")
+	physicalPort := &models.PhysicalPort{
+		Product:            models.Product{Bandwidth: 10000},
+		AvailableBandwidth: 4000,
+	}
+
+	tests := []struct {
+		name      string
+		planned   map[string]int64
+		created   []string
+		bandwidth int64
+		expect    diag.Diagnostics
+	}{
+		{
+			name:      "enough headroom",
+			bandwidth: 1000,
+		},
+		{
+			name:      "port left below the headroom",
+			bandwidth: 3500,
+			expect: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(skuPath, "Low physical port headroom",
+				"Once the access node is created, physical port port is left with 500 Mbps of its 10000 Mbps, "+
+					"below the 10% headroom (1000 Mbps) configured by physical_port_headroom on the provider.")},
+		},
+		{
+			name:      "port oversubscribed",
+			bandwidth: 5000,
+			expect: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(skuPath, "Physical port oversubscribed",
+				"The access node needs 5000 Mbps on physical port port, which only has 4000 Mbps available "+
+					"once the other access nodes planned on it are created. Choose a product with a lower bandwidth, "+
+					"or another physical port.")},
+		},
+		{
+			name:      "port oversubscribed by the access nodes planned on it",
+			planned:   map[string]int64{"other": 2000},
+			bandwidth: 3000,
+			expect: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(skuPath, "Physical port oversubscribed",
+				"The access node needs 3000 Mbps on physical port port, which only has 2000 Mbps available "+
+					"once the other access nodes planned on it are created. Choose a product with a lower bandwidth, "+
+					"or another physical port.")},
+		},
+		{
+			name:      "access node planned during the apply after the creation of another one on the port",
+			planned:   map[string]int64{"workspace/other": 2000},
+			created:   []string{"workspace/other"},
+			bandwidth: 3000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		r := &accessNodeResource{ports: physicalport.NewAllocator(), headroom: 10}
+		for name, bandwidth := range tc.planned {
+			r.ports.PlanBandwidth("port", name, bandwidth, int64(physicalPort.AvailableBandwidth))
+		}
+		for _, name := range tc.created {
+			r.ports.Created("port", name)
+		}
+
+		resp := &resource.ModifyPlanResponse{}
+		r.checkBandwidth(resp, physicalPort, "port", "workspace/node", tc.bandwidth)
+		assert.Equal(t, tc.expect, resp.Diagnostics)
+	}
+}
@@ -4,38 +4,59 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/intercloud/terraform-provider-autonomi/internal/transport"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 // attachmentResource is the resource implementation.
 type attachmentResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	sides   *transport.Sides
 }
 
 type attachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	NodeID      types.String `tfsdk:"node_id"`
-	TransportID types.String `tfsdk:"transport_id"`
-	State       types.String `tfsdk:"administrative_state"`
-	Side        types.String `tfsdk:"side"`
+	ID          types.String      `tfsdk:"id"`
+	CreatedAt   timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt   timetypes.RFC3339 `tfsdk:"updated_at"`
+	WorkspaceID types.String      `tfsdk:"workspace_id"`
+	NodeID      types.String      `tfsdk:"node_id"`
+	TransportID types.String      `tfsdk:"transport_id"`
+	State       types.String      `tfsdk:"administrative_state"`
+	Side        types.String      `tfsdk:"side"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &attachmentResource{}
-	_ resource.ResourceWithConfigure = &attachmentResource{}
+	_ resource.Resource                 = &attachmentResource{}
+	_ resource.ResourceWithConfigure    = &attachmentResource{}
+	_ resource.ResourceWithUpgradeState = &attachmentResource{}
+	_ resource.ResourceWithModifyPlan   = &attachmentResource{}
 )
 
+// attachmentReplacePaths are the attributes whose change replaces the attachment.
+var attachmentReplacePaths = []path.Path{
+	path.Root("workspace_id"),
+	path.Root("node_id"),
+	path.Root("transport_id"),
+	path.Root("side"),
+}
+
 // NewAttachmentResource is a helper function to simplify the provider implementation.
 func NewAttachmentResource() resource.Resource {
 	return &attachmentResource{}
@@ -49,18 +70,20 @@ func (r *attachmentResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.sides = clients.TransportSides
 }
 
 // Metadata returns the resource type name.
@@ -71,8 +94,10 @@ func (r *attachmentResource) Metadata(_ context.Context, req resource.MetadataRe
 // Schema defines the schema for the resource.
 func (r *attachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages an attachment resource.
-Attachment resource allows you to attach nodes and transports together, allowing traffic between them.`,
+Attachment resource allows you to attach nodes and transports together, allowing traffic between them.
+The node must be located at one of the ends of the transport, a warning being raised when their bandwidths differ.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "ID of the attachment, set after creation",
@@ -82,38 +107,84 @@ Attachment resource allows you to attach nodes and transports together, allowing
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the attachment",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the attachment",
 				Computed:            true,
 			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the workspace to which the attachment belongs.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"node_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the node attached to the transport",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"transport_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the transport attached to the node.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the attachment [creation_pending, creation_proceed, creation_error,
 deployed, delete_pending, delete_proceed, delete_error]`,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"side": schema.StringAttribute{
-				MarkdownDescription: "Direction of the attachment",
-				Computed:            true,
+				MarkdownDescription: `Side of the transport the node is attached to [A, Z], chosen by the platform when not set.
+The plan fails when the side, or both sides, of the transport are already attached, or when the node is already attached to the transport.
+The attachments existing in the workspace are checked along with the planned ones, without the attachments planned to be replaced or destroyed.`,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "Z"),
+				},
 			},
 		},
 	}
 }
 
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *attachmentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at")
+		}),
+	}
+}
+
 // CreateAttachment creates the resource and sets the initial Terraform state.
 func (r *attachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -124,10 +195,22 @@ func (r *attachmentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// nodes and transports created along with the attachment could not be checked at plan time, they are
+	// checked before the creation, the errors stopping it and the warnings, e.g. on bandwidths, being reported
+	attachedNode, nodeErr := r.client.GetNode(ctx, plan.WorkspaceID.ValueString(), plan.NodeID.ValueString())
+	attachedTransport, transportErr := r.client.GetTransport(ctx, plan.WorkspaceID.ValueString(), plan.TransportID.ValueString())
+	if nodeErr == nil && transportErr == nil {
+		resp.Diagnostics.Append(r.checkCompatibility(attachedNode, attachedTransport)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Generate API request body from plan
 	payload := models.CreateAttachment{
 		NodeID:      plan.NodeID.ValueString(),
 		TransportID: plan.TransportID.ValueString(),
+		Side:        plan.Side.ValueString(),
 	}
 
 	// Create new attachment
@@ -150,8 +233,8 @@ func (r *attachmentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(attachment.ID.String())
-	plan.CreatedAt = types.StringValue(attachment.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(attachment.UpdatedAt.String())
+	plan.CreatedAt = timestampValue(attachment.CreatedAt)
+	plan.UpdatedAt = timestampValue(attachment.UpdatedAt)
 	plan.State = types.StringValue(attachment.State.String())
 	plan.NodeID = types.StringValue(attachment.NodeID)
 	plan.TransportID = types.StringValue(attachment.TransportID)
@@ -186,8 +269,8 @@ func (r *attachmentResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(attachment.ID.String())
-	state.CreatedAt = types.StringValue(attachment.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(attachment.UpdatedAt.String())
+	state.CreatedAt = timestampValue(attachment.CreatedAt)
+	state.UpdatedAt = timestampValue(attachment.UpdatedAt)
 	state.State = types.StringValue(attachment.State.String())
 	state.NodeID = types.StringValue(attachment.NodeID)
 	state.TransportID = types.StringValue(attachment.TransportID)
@@ -204,6 +287,216 @@ func (r *attachmentResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *attachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 }
 
+// ModifyPlan checks the node and the transport of a new attachment belong to its workspace, that the
+// node can be attached to a free side of the transport, and that their locations and bandwidths match.
+// The sides of the transports are seeded with the attachments existing in the workspace.
+func (r *attachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() {
+		var state attachmentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.seedSides(ctx, resp, state.WorkspaceID.ValueString())
+
+		// destroyed attachments free their side, the replacement of an attachment being planned as a
+		// creation afterwards
+		if req.Plan.Raw.IsNull() {
+			r.detachSide(state)
+			return
+		}
+		if _, replaced := replacingPath(ctx, req, resp, attachmentReplacePaths...); replaced {
+			r.detachSide(state)
+			return
+		}
+		r.attachSide(resp, state)
+		return
+	}
+
+	var plan attachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.WorkspaceID.IsUnknown() {
+		return
+	}
+	r.seedSides(ctx, resp, plan.WorkspaceID.ValueString())
+
+	// nodes and transports created along with the attachment are not known yet
+	var attachedNode *models.Node
+	var attachedTransport *models.Transport
+	var err error
+	if r.client != nil && !plan.NodeID.IsUnknown() {
+		attachedNode, err = r.client.GetNode(ctx, plan.WorkspaceID.ValueString(), plan.NodeID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("node_id"),
+				"Node not found in workspace",
+				fmt.Sprintf("Could not read node %s in workspace %s: %s", plan.NodeID.ValueString(), plan.WorkspaceID.ValueString(), err.Error()),
+			)
+		}
+	}
+	if r.client != nil && !plan.TransportID.IsUnknown() {
+		attachedTransport, err = r.client.GetTransport(ctx, plan.WorkspaceID.ValueString(), plan.TransportID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transport_id"),
+				"Transport not found in workspace",
+				fmt.Sprintf("Could not read transport %s in workspace %s: %s", plan.TransportID.ValueString(), plan.WorkspaceID.ValueString(), err.Error()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.attachSide(resp, plan)
+	if resp.Diagnostics.HasError() || attachedNode == nil || attachedTransport == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkCompatibility(attachedNode, attachedTransport)...)
+}
+
+// checkCompatibility looks the products of the node and of the transport up in the catalog, and checks
+// the node can be attached to the transport. Products withdrawn from the catalog are not checked.
+func (r *attachmentResource) checkCompatibility(attachedNode *models.Node, attachedTransport *models.Transport) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.catalog == nil {
+		return diags
+	}
+
+	var transportProduct productsmodels.TransportProduct
+	var nodeProduct productsmodels.Product
+	found, err := transportProductIndex.lookup(r.catalog, attachedTransport.Product.SKU, &transportProduct)
+	if err == nil && found {
+		for _, index := range nodeProductIndexes {
+			if found, err = index.lookup(r.catalog, attachedNode.Product.SKU, &nodeProduct); err != nil || found {
+				break
+			}
+		}
+	}
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check the attachment",
+			"Could not read the products of the node and of the transport from the catalog: "+err.Error(),
+		)
+		return diags
+	}
+	if !found {
+		return diags
+	}
+
+	return attachmentCompatibility(attachedNode.Name, nodeProduct, attachedTransport.Name, transportProduct)
+}
+
+// attachmentCompatibility raises an error when the location of the node is not one of the ends of the
+// transport, and a warning when their bandwidths differ.
+func attachmentCompatibility(nodeName string, nodeProduct productsmodels.Product, transportName string, transportProduct productsmodels.TransportProduct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if nodeProduct.Location != transportProduct.Location && nodeProduct.Location != transportProduct.LocationTo {
+		diags.AddAttributeError(
+			path.Root("node_id"),
+			"Node location not served by the transport",
+			fmt.Sprintf("Node %q is located in %s, while transport %q runs from %s to %s. Attach the node to a "+
+				"transport with an end in %s.", nodeName, nodeProduct.Location, transportName, transportProduct.Location,
+				transportProduct.LocationTo, nodeProduct.Location),
+		)
+	}
+
+	if nodeProduct.Bandwidth != transportProduct.Bandwidth {
+		diags.AddAttributeWarning(
+			path.Root("transport_id"),
+			"Bandwidth mismatch",
+			fmt.Sprintf("Node %q has a bandwidth of %d Mbps, while transport %q has %d Mbps: the traffic between "+
+				"them is limited to the lowest one.", nodeName, nodeProduct.Bandwidth, transportName, transportProduct.Bandwidth),
+		)
+	}
+
+	return diags
+}
+
+// attachSide records the node of the attachment on a side of its transport, raising an error when the node
+// is already attached to the transport, or when the side of the transport is not free.
+func (r *attachmentResource) attachSide(resp *resource.ModifyPlanResponse, attachment attachmentResourceModel) {
+	if r.sides == nil || attachment.TransportID.IsUnknown() {
+		return
+	}
+
+	transportID := attachment.TransportID.ValueString()
+	side := transport.Attachment{ID: attachment.ID.ValueString()}
+	if !attachment.NodeID.IsUnknown() {
+		side.NodeID = attachment.NodeID.ValueString()
+	}
+	if !attachment.Side.IsUnknown() {
+		side.Side = attachment.Side.ValueString()
+	}
+
+	switch r.sides.Attach(transportID, side) {
+	case transport.ErrNodeAttached:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("node_id"),
+			"Node already attached",
+			fmt.Sprintf("Node %s is already attached to transport %s.", side.NodeID, transportID),
+		)
+	case transport.ErrSideAttached:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("side"),
+			"Transport side already attached",
+			fmt.Sprintf("Side %s of transport %s is already attached to another node. Choose the other side, "+
+				"or do not set side so the platform chooses it.", side.Side, transportID),
+		)
+	case transport.ErrNoFreeSide:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("transport_id"),
+			"No free transport side",
+			fmt.Sprintf("Both sides of transport %s are already attached.", transportID),
+		)
+	}
+}
+
+// detachSide frees the side of the transport of the attachment planned to be replaced or destroyed.
+func (r *attachmentResource) detachSide(attachment attachmentResourceModel) {
+	if r.sides == nil {
+		return
+	}
+	r.sides.Detach(attachment.TransportID.ValueString(), attachment.ID.ValueString())
+}
+
+// seedSides seeds the sides of the transports with the attachments existing in the workspace. A warning is
+// raised when they cannot be listed, only the planned attachments being known then.
+func (r *attachmentResource) seedSides(ctx context.Context, resp *resource.ModifyPlanResponse, workspaceID string) {
+	if r.client == nil || r.sides == nil {
+		return
+	}
+
+	err := r.sides.Seed(workspaceID, func() (map[string][]transport.Attachment, error) {
+		attachments, err := r.client.ListAttachments(ctx, workspaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		existing := map[string][]transport.Attachment{}
+		for _, attachment := range *attachments {
+			switch attachment.State {
+			case models.AdministrativeStateDeletePending, models.AdministrativeStateDeleteProceed, models.AdministrativeStateDeleted:
+				continue
+			}
+			existing[attachment.TransportID] = append(existing[attachment.TransportID], transport.Attachment{
+				ID:     attachment.ID.String(),
+				NodeID: attachment.NodeID,
+				Side:   attachment.Side,
+			})
+		}
+		return existing, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check the transport sides",
+			fmt.Sprintf("Could not list the attachments of workspace %s, only the planned attachments are checked: %s", workspaceID, err.Error()),
+		)
+	}
+}
+
 func (r *attachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state attachmentResourceModel
@@ -0,0 +1,141 @@
+package autonomiresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentCompatibility(t *testing.T) {
+	transportProduct := productsmodels.TransportProduct{
+		Product:    productsmodels.Product{Location: "FR5", Bandwidth: 1000},
+		LocationTo: "LD5",
+	}
+
+	tests := []struct {
+		name        string
+		nodeProduct productsmodels.Product
+		expect      diag.Diagnostics
+	}{
+		{
+			name:        "node at the origin",
+			nodeProduct: productsmodels.Product{Location: "FR5", Bandwidth: 1000},
+		},
+		{
+			name:        "node at the destination",
+			nodeProduct: productsmodels.Product{Location: "LD5", Bandwidth: 1000},
+		},
+		{
+			name:        "node elsewhere",
+			nodeProduct: productsmodels.Product{Location: "AM2", Bandwidth: 1000},
+			expect: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("node_id"), "Node location not served by the transport",
+				`Node "node" is located in AM2, while transport "transport" runs from FR5 to LD5. Attach the node to a transport with an end in AM2.`)},
+		},
+		{
+			name:        "bandwidth mismatch",
+			nodeProduct: productsmodels.Product{Location: "LD5", Bandwidth: 500},
+			expect: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(path.Root("transport_id"), "Bandwidth mismatch",
+				`Node "node" has a bandwidth of 500 Mbps, while transport "transport" has 1000 Mbps: the traffic between them is limited to the lowest one.`)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, attachmentCompatibility("node", tc.nodeProduct, "transport", transportProduct))
+	}
+}
+
+// attachmentValue returns an attachment of the node to the side of the transport, the other attributes
+// being null. A nil `id` is unknown.
+func attachmentValue(t *testing.T, id any, nodeID, side string) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	return resourceValue(t, &attachmentResource{}, map[string]tftypes.Value{
+		"id":           stringValue(id),
+		"workspace_id": tftypes.NewValue(tftypes.String, "workspace"),
+		"transport_id": tftypes.NewValue(tftypes.String, "transport"),
+		"node_id":      tftypes.NewValue(tftypes.String, nodeID),
+		"side":         tftypes.NewValue(tftypes.String, side),
+	})
+}
+
+func TestAttachmentModifyPlanSides(t *testing.T) {
+	existing, schemaResp := attachmentValue(t, "attachment-a", "node-a", "A")
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+	sideA, _ := attachmentValue(t, nil, "node-b", "A")
+	sideZ, _ := attachmentValue(t, nil, "node-z", "Z")
+	replacement, _ := attachmentValue(t, nil, "node-c", "A")
+	sideAttached := diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("side"), "Transport side already attached",
+		"Side A of transport transport is already attached to another node. Choose the other side, "+
+			"or do not set side so the platform chooses it.")}
+
+	type step struct {
+		state  tftypes.Value
+		plan   tftypes.Value
+		expect diag.Diagnostics
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "side of an existing attachment",
+			steps: []step{
+				{state: destroyed, plan: sideA, expect: sideAttached},
+				{state: destroyed, plan: sideZ},
+			},
+		},
+		{
+			name: "existing attachment planned again",
+			steps: []step{
+				{state: existing, plan: existing},
+				{state: destroyed, plan: sideZ},
+			},
+		},
+		{
+			name: "side of a destroyed attachment",
+			steps: []step{
+				{state: existing, plan: destroyed},
+				{state: destroyed, plan: sideA},
+			},
+		},
+		{
+			name: "replaced attachment, its replacement being planned as a creation",
+			steps: []step{
+				{state: existing, plan: replacement},
+				{state: destroyed, plan: replacement},
+				{state: destroyed, plan: sideA, expect: sideAttached},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		r := &attachmentResource{sides: transport.NewSides()}
+		assert.NoError(t, r.sides.Seed("workspace", func() (map[string][]transport.Attachment, error) {
+			return map[string][]transport.Attachment{
+				"transport": {{ID: "attachment-a", NodeID: "node-a", Side: "A"}},
+			}, nil
+		}))
+
+		for _, step := range tc.steps {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: step.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: step.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+			assert.Equal(t, step.expect, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package autonomiresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+)
+
+// checkWorkspaceBudget registers the planned element in the ledger of its workspace, priced from the
+// catalog, and raises an error when the elements of the workspace exceed its budget. The ledger is first
+// seeded with the elements existing in the workspace, the planned element taking the place of the one it
+// updates or replaces. Elements planned for destruction are removed from the ledger.
+func checkWorkspaceBudget(ctx context.Context, client *autonomisdk.Client, ledger *budget.Ledger, prices *budget.Prices, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, element string) {
+	if ledger == nil {
+		return
+	}
+
+	// the element is keyed by its ID once created, the ID being unknown when it is replaced
+	var id types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	}
+	if resp.Plan.Raw.IsNull() {
+		var workspaceID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+		if !resp.Diagnostics.HasError() {
+			seedWorkspaceBudget(ctx, client, ledger, prices, resp, workspaceID.ValueString())
+			ledger.Remove(workspaceID.ValueString(), id.ValueString())
+		}
+		return
+	}
+
+	var workspaceID, name types.String
+	var plannedProduct product
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("product"), &plannedProduct)...)
+	// elements of workspaces not created yet cannot be matched with their workspace
+	if resp.Diagnostics.HasError() || workspaceID.IsUnknown() || name.IsUnknown() || plannedProduct.SKU.IsUnknown() {
+		return
+	}
+	seedWorkspaceBudget(ctx, client, ledger, prices, resp, workspaceID.ValueString())
+
+	ledger.Register(workspaceID.ValueString(), budget.Element{
+		Key:         id.ValueString(),
+		Name:        fmt.Sprintf("%s %q", element, name.ValueString()),
+		SKU:         plannedProduct.SKU.ValueString(),
+		MonthlyCost: monthlyPrice(prices, plannedProduct.SKU.ValueString(), plannedProduct.PriceMRC.ValueInt64()),
+	})
+	if summary, detail, exceeded := workspaceBudgetExceeded(ledger, workspaceID.ValueString()); exceeded {
+		resp.Diagnostics.AddAttributeError(path.Root("product").AtName("sku"), summary, detail)
+	}
+}
+
+// workspaceBudgetExceeded returns the diagnostic raised when the elements of the workspace exceed its
+// budget, with the breakdown of the monthly cost by element.
+func workspaceBudgetExceeded(ledger *budget.Ledger, workspaceID string) (string, string, bool) {
+	total, elements, exceeded := ledger.Exceeded(workspaceID)
+	if !exceeded {
+		return "", "", false
+	}
+
+	maxMonthlyCost, _ := ledger.Budget(workspaceID)
+	breakdown := make([]string, 0, len(elements))
+	for _, element := range elements {
+		breakdown = append(breakdown, fmt.Sprintf("  - %s (%s): %d", element.Name, element.SKU, element.MonthlyCost))
+	}
+	return "Workspace budget exceeded",
+		fmt.Sprintf("The planned monthly cost of workspace %s is %d, over its max_monthly_cost of %d:\n%s",
+			workspaceID, total, maxMonthlyCost, strings.Join(breakdown, "\n")),
+		true
+}
+
+// monthlyPrice returns the monthly recurring price of the product, the catalog price prevailing over the
+// given one, which is used when the catalog cannot be read.
+func monthlyPrice(prices *budget.Prices, sku string, priceMRC int64) int64 {
+	if prices != nil {
+		if price, found, err := prices.MonthlyPrice(sku); err == nil && found {
+			return price
+		}
+	}
+	return priceMRC
+}
+
+// seedWorkspaceBudget seeds the ledger with the nodes and the transports existing in the workspace, keyed
+// by their ID. A warning is raised when they cannot be listed, only the planned elements being counted then.
+func seedWorkspaceBudget(ctx context.Context, client *autonomisdk.Client, ledger *budget.Ledger, prices *budget.Prices, resp *resource.ModifyPlanResponse, workspaceID string) {
+	if client == nil {
+		return
+	}
+
+	err := ledger.Seed(workspaceID, func() ([]budget.Element, error) {
+		nodes, err := client.ListNodes(ctx, workspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("could not list the nodes: %w", err)
+		}
+		transports, err := client.ListTransports(ctx, workspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("could not list the transports: %w", err)
+		}
+
+		elements := make([]budget.Element, 0, len(*nodes)+len(*transports))
+		for _, node := range *nodes {
+			elements = append(elements, budget.Element{
+				Key:         node.ID.String(),
+				Name:        fmt.Sprintf("%s node %q", node.Type, node.Name),
+				SKU:         node.Product.SKU,
+				MonthlyCost: monthlyPrice(prices, node.Product.SKU, int64(node.Product.PriceMRC)),
+			})
+		}
+		for _, transport := range *transports {
+			elements = append(elements, budget.Element{
+				Key:         transport.ID.String(),
+				Name:        fmt.Sprintf("transport %q", transport.Name),
+				SKU:         transport.Product.SKU,
+				MonthlyCost: monthlyPrice(prices, transport.Product.SKU, int64(transport.Product.PriceMRC)),
+			})
+		}
+		return elements, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check the workspace budget",
+			fmt.Sprintf("Could not read the elements of workspace %s, only the planned elements are counted in its budget: %s", workspaceID, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,81 @@
+package autonomiresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceBudgetExceeded(t *testing.T) {
+	maxMonthlyCost := int64(200)
+	ledger := budget.NewLedger(nil)
+	ledger.SetBudget("workspace", &maxMonthlyCost)
+	ledger.Register("workspace", budget.Element{Name: `transport "paris-frankfurt"`, SKU: "TRP-PAR-FRA-100", MonthlyCost: 150})
+
+	_, _, exceeded := workspaceBudgetExceeded(ledger, "workspace")
+	assert.False(t, exceeded)
+
+	ledger.Register("workspace", budget.Element{Name: `cloud node "aws"`, SKU: "AWS-PAR-100", MonthlyCost: 100})
+	summary, detail, exceeded := workspaceBudgetExceeded(ledger, "workspace")
+	assert.True(t, exceeded)
+	assert.Equal(t, "Workspace budget exceeded", summary)
+	assert.Equal(t, `The planned monthly cost of workspace workspace is 250, over its max_monthly_cost of 200:
+  - cloud node "aws" (AWS-PAR-100): 100
+  - transport "paris-frankfurt" (TRP-PAR-FRA-100): 150`, detail)
+}
+
+// budgetTransportValue returns a transport of the workspace named and priced as given, with its ID.
+// A nil `id` is unknown.
+func budgetTransportValue(t *testing.T, id any, name string, priceMRC int64) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	return resourceValue(t, &transportResource{}, map[string]tftypes.Value{
+		"id":                  stringValue(id),
+		"workspace_id":        tftypes.NewValue(tftypes.String, "workspace"),
+		"name":                tftypes.NewValue(tftypes.String, name),
+		"product.sku":         tftypes.NewValue(tftypes.String, "TRP-PAR-FRA-100"),
+		"product.price_mrc":   tftypes.NewValue(tftypes.Number, priceMRC),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+}
+
+func TestCheckWorkspaceBudget(t *testing.T) {
+	existing, schemaResp := budgetTransportValue(t, "transport-id", "paris-frankfurt", 150)
+	renamed, _ := budgetTransportValue(t, "transport-id", "paris-frankfurt-renamed", 150)
+	replaced, _ := budgetTransportValue(t, nil, "paris-frankfurt", 150)
+	created, _ := budgetTransportValue(t, nil, "paris-frankfurt", 150)
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+
+	maxMonthlyCost := int64(400)
+	ledger := budget.NewLedger(nil)
+	ledger.SetBudget("workspace", &maxMonthlyCost)
+	modifyPlan := func(state, plan tftypes.Value) diag.Diagnostics {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		checkWorkspaceBudget(context.Background(), nil, ledger, nil, req, resp, "transport")
+		return resp.Diagnostics
+	}
+
+	// the updated and the replaced transport take the place of the existing one
+	assert.Empty(t, modifyPlan(existing, renamed))
+	assert.Empty(t, modifyPlan(existing, replaced))
+	// transports created with the same name are counted apart
+	assert.Empty(t, modifyPlan(destroyed, created))
+	assert.True(t, modifyPlan(destroyed, created).HasError())
+	total, _, _ := ledger.Exceeded("workspace")
+	assert.Equal(t, int64(450), total)
+
+	// the destroyed transport is not counted anymore
+	assert.Empty(t, modifyPlan(existing, destroyed))
+	_, _, exceeded := ledger.Exceeded("workspace")
+	assert.False(t, exceeded)
+}
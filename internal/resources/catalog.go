@@ -0,0 +1,207 @@
+package autonomiresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/catalog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// maxSKUSuggestions is the number of SKUs suggested when a SKU is not found in the catalog.
+const maxSKUSuggestions = 3
+
+// catalogIndex describes where the products of a resource are listed in the catalog.
+type catalogIndex struct {
+	// name of the Meilisearch index
+	name string
+	// filters the products of the resource must match in the index
+	filters []string
+	// kind of product, used in diagnostics
+	kind string
+	// data source listing the products, used in diagnostics
+	dataSource string
+}
+
+var (
+	cloudProductIndex = catalogIndex{
+		name:       "cloudproduct",
+		kind:       "cloud",
+		dataSource: "autonomi_cloud_products",
+	}
+	physicalAccessProductIndex = catalogIndex{
+		name: "accessproduct",
+		filters: []string{
+			fmt.Sprintf("provider = %q", productsmodels.INTERCLOUD),
+			fmt.Sprintf("type = %q", productsmodels.PHYSICAL),
+		},
+		kind:       "physical access",
+		dataSource: "autonomi_access_products",
+	}
+	virtualAccessProductIndex = catalogIndex{
+		name: "accessproduct",
+		filters: []string{
+			fmt.Sprintf("type = %q", productsmodels.VIRTUAL),
+		},
+		kind:       "virtual access",
+		dataSource: "autonomi_virtual_access_products",
+	}
+	transportProductIndex = catalogIndex{
+		name:       "transportproduct",
+		kind:       "transport",
+		dataSource: "autonomi_transport_products",
+	}
+	physicalPortProductIndex = catalogIndex{
+		name:       "portproduct",
+		kind:       "physical port",
+		dataSource: "autonomi_physical_port_products",
+	}
+
+	// nodeProductIndexes lists the product indexes of the nodes, whatever their kind.
+	nodeProductIndexes = []catalogIndex{
+		cloudProductIndex,
+		{name: "accessproduct", kind: "access"},
+	}
+
+	// catalogIndexes lists every product index, to tell which kind of product a misplaced SKU is.
+	catalogIndexes = append(append([]catalogIndex{}, nodeProductIndexes...),
+		transportProductIndex,
+		physicalPortProductIndex,
+	)
+)
+
+// search runs the query on the index, restricted to its products, and decodes the hits.
+func (i catalogIndex) search(client *meilisearch.Client, query string, filters []string, limit int64, hits any) error {
+	respProducts, err := client.Index(i.name).Search(query, &meilisearch.SearchRequest{
+		Filter: append(append([]string{}, i.filters...), filters...),
+		Limit:  limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	hitsJSON, err := json.Marshal(respProducts.Hits)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(hitsJSON, hits)
+}
+
+// skuLookup returns the lookup of the products of the index having the SKU.
+func (i catalogIndex) skuLookup(sku string) catalog.SKULookup {
+	return catalog.SKULookup{Index: i.name, Filters: i.filters, SKU: sku}
+}
+
+// lookup decodes the product of the SKU into `product`, false being returned when the index does not list it.
+func (i catalogIndex) lookup(client *meilisearch.Client, sku string, product any) (bool, error) {
+	return catalog.LookupSKU(client, i.skuLookup(sku), product)
+}
+
+// suggest returns the SKUs of the index closest to the given one.
+func (i catalogIndex) suggest(client *meilisearch.Client, sku string) ([]string, error) {
+	var hits []productsmodels.Product
+	if err := i.search(client, sku, nil, maxSKUSuggestions, &hits); err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		suggestions = append(suggestions, hit.SKU)
+	}
+	return suggestions, nil
+}
+
+// unknownSKUDetail explains why the SKU is not a product of the index, telling which kind of product it
+// is when listed in another index, and suggesting the closest SKUs of the index.
+func (i catalogIndex) unknownSKUDetail(client *meilisearch.Client, sku string) string {
+	detail := fmt.Sprintf("The SKU %q is not a %s product of the catalog.", sku, i.kind)
+	lookups := make([]catalog.SKULookup, 0, len(catalogIndexes))
+	for _, other := range catalogIndexes {
+		lookups = append(lookups, other.skuLookup(sku))
+	}
+	if products, err := catalog.LookupSKUs(client, lookups, 1); err == nil {
+		for j, other := range catalogIndexes {
+			if len(products[j]) > 0 {
+				detail += fmt.Sprintf(" It is listed among the %s products.", other.kind)
+				break
+			}
+		}
+	}
+
+	if suggestions, err := i.suggest(client, sku); err == nil && len(suggestions) > 0 {
+		detail += fmt.Sprintf(" Closest %s products: %s.", i.kind, strings.Join(suggestions, ", "))
+	}
+	return detail + fmt.Sprintf(" Use the %s data source to find a valid SKU.", i.dataSource)
+}
+
+// checkProductSKU looks the planned `product.sku` up in the catalog index, setting the planned product
+// details and decoding it into `product`. It raises an error when the index does not list the SKU, and a warning when the catalog cannot be
+// read. The SKU is only checked on creation, or when it or one of the `triggers` attributes changes,
+// so existing elements are not broken by products withdrawn from the catalog since.
+// It returns whether the product was found.
+func checkProductSKU(ctx context.Context, client *meilisearch.Client, index catalogIndex, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, product any, triggers ...path.Path) bool {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	skuPath := path.Root("product").AtName("sku")
+	var sku types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, skuPath, &sku)...)
+	if resp.Diagnostics.HasError() || sku.IsNull() || sku.IsUnknown() {
+		return false
+	}
+
+	if !req.State.Raw.IsNull() {
+		changed := false
+		for _, p := range append([]path.Path{skuPath}, triggers...) {
+			var planValue, stateValue attr.Value
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+			if resp.Diagnostics.HasError() {
+				return false
+			}
+			changed = changed || !planValue.Equal(stateValue)
+		}
+		if !changed {
+			return false
+		}
+	}
+
+	var hit json.RawMessage
+	found, err := index.lookup(client, sku.ValueString(), &hit)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			skuPath,
+			"Unable to check the product",
+			"Could not read the product "+sku.ValueString()+" from the catalog: "+err.Error(),
+		)
+		return false
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(skuPath, "Unknown product", index.unknownSKUDetail(client, sku.ValueString()))
+		return false
+	}
+
+	var catalogProduct productsmodels.Product
+	err = json.Unmarshal(hit, &catalogProduct)
+	if err == nil && product != nil {
+		err = json.Unmarshal(hit, product)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			skuPath,
+			"Unable to check the product",
+			"Could not decode the product "+sku.ValueString()+" from the catalog: "+err.Error(),
+		)
+		return false
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("product"), productFromCatalog(catalogProduct))...)
+	return !resp.Diagnostics.HasError()
+}
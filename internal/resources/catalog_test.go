@@ -0,0 +1,145 @@
+package autonomiresource
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var catalogFilterRegexp = regexp.MustCompile(`^(\w+) = "(.*)"$`)
+
+// testCatalogSearch is a search request of the test catalog.
+type testCatalogSearch struct {
+	IndexUID string   `json:"indexUid"`
+	Query    string   `json:"q"`
+	Filter   []string `json:"filter"`
+	Limit    int      `json:"limit"`
+}
+
+// newTestCatalog serves the given products per index, supporting the equality filters only, and the
+// multi-search. A search query matches the products whose SKU shares its first dash separated part.
+func newTestCatalog(t *testing.T, indexes map[string][]map[string]any) *meilisearch.Client {
+	search := func(request testCatalogSearch) map[string]any {
+		hits := []map[string]any{}
+		for _, product := range indexes[request.IndexUID] {
+			match := true
+			for _, filter := range request.Filter {
+				parts := catalogFilterRegexp.FindStringSubmatch(filter)
+				match = match && parts != nil && product[parts[1]] == parts[2]
+			}
+			if request.Query != "" {
+				prefix, _, _ := strings.Cut(request.Query, "-")
+				match = match && strings.HasPrefix(product["sku"].(string), prefix)
+			}
+			if match && (request.Limit == 0 || len(hits) < request.Limit) {
+				hits = append(hits, product)
+			}
+		}
+		return map[string]any{"indexUid": request.IndexUID, "hits": hits}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/multi-search" {
+			var request struct {
+				Queries []testCatalogSearch `json:"queries"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Error(err)
+			}
+
+			results := []map[string]any{}
+			for _, query := range request.Queries {
+				results = append(results, search(query))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": results})
+			return
+		}
+
+		var request testCatalogSearch
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		request.IndexUID = strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/indexes/"), "/search")
+		_ = json.NewEncoder(w).Encode(search(request))
+	}))
+	t.Cleanup(server.Close)
+
+	return meilisearch.NewClient(meilisearch.ClientConfig{Host: server.URL})
+}
+
+func TestCatalogIndex(t *testing.T) {
+	client := newTestCatalog(t, map[string][]map[string]any{
+		"cloudproduct": {
+			{"sku": "AWS-PAR-100", "cspName": "AWS"},
+			{"sku": "AWS-PAR-200", "cspName": "AWS"},
+		},
+		"accessproduct": {
+			{"sku": "ICL-PAR-1G", "provider": "InterCloud", "type": "PHYSICAL"},
+			{"sku": "MGP-PAR-1G", "provider": "MEGAPORT", "type": "PHYSICAL"},
+		},
+		"transportproduct": {
+			{"sku": "TRP-PAR-FRA-100"},
+		},
+	})
+
+	var cloudProduct struct {
+		CSPName string `json:"cspName"`
+	}
+	found, err := cloudProductIndex.lookup(client, "AWS-PAR-200", &cloudProduct)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "AWS", cloudProduct.CSPName)
+
+	tests := []struct {
+		name   string
+		index  catalogIndex
+		sku    string
+		found  bool
+		detail string
+	}{
+		{
+			name:  "physical access product",
+			index: physicalAccessProductIndex,
+			sku:   "ICL-PAR-1G",
+			found: true,
+		},
+		{
+			name:  "access product of another provider",
+			index: physicalAccessProductIndex,
+			sku:   "MGP-PAR-1G",
+			detail: `The SKU "MGP-PAR-1G" is not a physical access product of the catalog. It is listed among the access products.` +
+				` Use the autonomi_access_products data source to find a valid SKU.`,
+		},
+		{
+			name:  "transport product used as a cloud product",
+			index: cloudProductIndex,
+			sku:   "TRP-PAR-FRA-100",
+			detail: `The SKU "TRP-PAR-FRA-100" is not a cloud product of the catalog. It is listed among the transport products.` +
+				` Use the autonomi_cloud_products data source to find a valid SKU.`,
+		},
+		{
+			name:  "misspelled cloud product",
+			index: cloudProductIndex,
+			sku:   "AWS-PAR-1000",
+			detail: `The SKU "AWS-PAR-1000" is not a cloud product of the catalog. Closest cloud products: AWS-PAR-100, AWS-PAR-200.` +
+				` Use the autonomi_cloud_products data source to find a valid SKU.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		found, err := tc.index.lookup(client, tc.sku, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.found, found)
+		if !found {
+			assert.Equal(t, tc.detail, tc.index.unknownSKUDetail(client, tc.sku))
+		}
+	}
+}
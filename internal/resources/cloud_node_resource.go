@@ -3,56 +3,161 @@ package autonomiresource
 import (
 	"context"
 	"fmt"
-
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 // cloudNodeResource is the resource implementation.
 type cloudNodeResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	// enforceCommitmentTerms raises commitment term warnings as errors
+	enforceCommitmentTerms bool
+	budget                 *budget.Ledger
+	prices                 *budget.Prices
+}
+
+type cloudNodeAWS struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	DxconID      types.String `tfsdk:"dxcon_id"`
 }
 
-type product struct {
-	// Provider types.String `tfsdk:"provider"`
-	SKU types.String `tfsdk:"sku"`
+type cloudNodeAzure struct {
+	ServiceKey          types.String `tfsdk:"service_key"`
+	ServiceKeyWO        types.String `tfsdk:"service_key_wo"`
+	ServiceKeyWOVersion types.Int64  `tfsdk:"service_key_wo_version"`
 }
 
-type providerCloudConfig struct {
-	AWSAccountID    types.String `tfsdk:"aws_account_id"`
-	GCPPairingKey   types.String `tfsdk:"gcp_pairing_key"`
-	AzureServiceKey types.String `tfsdk:"azure_service_key"`
+type cloudNodeGCP struct {
+	PairingKey types.String `tfsdk:"pairing_key"`
 }
 
 type cloudNodeResourceModel struct {
-	ID             types.String        `tfsdk:"id"`
-	WorkspaceID    types.String        `tfsdk:"workspace_id"`
-	CreatedAt      types.String        `tfsdk:"created_at"`
-	UpdatedAt      types.String        `tfsdk:"updated_at"`
-	DeployedAt     types.String        `tfsdk:"deployed_at"`
-	Name           types.String        `tfsdk:"name"`
-	State          types.String        `tfsdk:"administrative_state"`
-	Type           types.String        `tfsdk:"type"`
-	Product        product             `tfsdk:"product"`
-	ProviderConfig providerCloudConfig `tfsdk:"provider_config"`
-	ConnectionID   types.String        `tfsdk:"connection_id"`
-	Vlan           types.Int64         `tfsdk:"vlan"`
-	DxconID        types.String        `tfsdk:"dxcon_id"`
+	ID                 types.String      `tfsdk:"id"`
+	WorkspaceID        types.String      `tfsdk:"workspace_id"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt          timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt         timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name               types.String      `tfsdk:"name"`
+	State              types.String      `tfsdk:"administrative_state"`
+	Type               types.String      `tfsdk:"type"`
+	Product            product           `tfsdk:"product"`
+	AWS                *cloudNodeAWS     `tfsdk:"aws"`
+	Azure              *cloudNodeAzure   `tfsdk:"azure"`
+	GCP                *cloudNodeGCP     `tfsdk:"gcp"`
+	Vlan               types.Int64       `tfsdk:"vlan"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+}
+
+// fromNode maps the API cloud node onto the model, only the block of the node cloud provider being set.
+// The secrets echoed back by the API are only used when the block is not set yet (e.g. on import), so
+// a write-only secret never lands in the state.
+// The workspace ID and the deletion protection are not returned by the API and are left untouched, the
+// product being set by the caller.
+func (m *cloudNodeResourceModel) fromNode(node *models.Node) {
+	m.ID = types.StringValue(node.ID.String())
+	m.CreatedAt = timestampValue(node.CreatedAt)
+	m.UpdatedAt = timestampValue(node.UpdatedAt)
+	m.DeployedAt = timestampValue(node.DeployedAt)
+	m.Name = types.StringValue(node.Name)
+	m.State = types.StringValue(node.State.String())
+	m.Type = types.StringValue(node.Type.String())
+	m.Vlan = types.Int64Value(node.Vlan)
+
+	// the prior block is kept when the API does not return the provider configuration
+	if config := node.ProviderConfig; config != nil {
+		switch {
+		case config.AccountID != "":
+			m.AWS, m.Azure, m.GCP = &cloudNodeAWS{AccountID: types.StringValue(config.AccountID)}, nil, nil
+		case config.ServiceKey != "":
+			if m.Azure == nil {
+				m.Azure = &cloudNodeAzure{ServiceKey: types.StringValue(config.ServiceKey)}
+			}
+			m.AWS, m.GCP = nil, nil
+		case config.PairingKey != "":
+			if m.GCP == nil {
+				m.GCP = &cloudNodeGCP{PairingKey: types.StringValue(config.PairingKey)}
+			}
+			m.AWS, m.Azure = nil, nil
+		}
+	}
+	if m.AWS != nil {
+		m.AWS.ConnectionID = types.StringValue(node.ConnectionID)
+		m.AWS.DxconID = types.StringValue(node.DxconID)
+	}
+}
+
+// providerConfig returns the API provider configuration of the cloud provider block set.
+// The write-only Azure service key, only available in the configuration, is used when set.
+func (m *cloudNodeResourceModel) providerConfig(serviceKeyWO types.String) *models.ProviderCloudConfig {
+	config := &models.ProviderCloudConfig{}
+	switch {
+	case m.AWS != nil:
+		config.AccountID = m.AWS.AccountID.ValueString()
+	case m.Azure != nil:
+		config.ServiceKey = m.Azure.ServiceKey.ValueString()
+		if !serviceKeyWO.IsNull() {
+			config.ServiceKey = serviceKeyWO.ValueString()
+		}
+	case m.GCP != nil:
+		config.PairingKey = m.GCP.PairingKey.ValueString()
+	}
+	return config
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &cloudNodeResource{}
-	_ resource.ResourceWithConfigure = &cloudNodeResource{}
+	_ resource.Resource                     = &cloudNodeResource{}
+	_ resource.ResourceWithConfigure        = &cloudNodeResource{}
+	_ resource.ResourceWithUpgradeState     = &cloudNodeResource{}
+	_ resource.ResourceWithModifyPlan       = &cloudNodeResource{}
+	_ resource.ResourceWithConfigValidators = &cloudNodeResource{}
+	_ resource.ResourceWithValidateConfig   = &cloudNodeResource{}
 )
 
+// cloudNodeProviders maps the cloud provider blocks to the cspName of the cloud products they apply to.
+var cloudNodeProviders = map[string]string{
+	"aws":   "AWS",
+	"azure": "Azure",
+	"gcp":   "GCP",
+}
+
+// cloudNodeImmutablePaths lists the attributes that cannot be updated in place. Changing the cloud provider
+// changes the input of the block of the former one.
+var cloudNodeImmutablePaths = []path.Path{
+	path.Root("workspace_id"),
+	path.Root("product").AtName("sku"),
+	path.Root("aws").AtName("account_id"),
+	path.Root("azure").AtName("service_key"),
+	path.Root("gcp").AtName("pairing_key"),
+}
+
+// cloudNodeReplacePaths lists the attributes whose change replaces the cloud node.
+var cloudNodeReplacePaths = []path.Path{
+	path.Root("azure").AtName("service_key_wo_version"),
+}
+
 // NewCloudNodeResource is a helper function to simplify the provider implementation.
 func NewCloudNodeResource() resource.Resource {
 	return &cloudNodeResource{}
@@ -66,18 +171,22 @@ func (r *cloudNodeResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.enforceCommitmentTerms = clients.EnforceCommitmentTerms
+	r.budget = clients.Budget
+	r.prices = clients.Prices
 }
 
 // Metadata returns the resource type name.
@@ -88,9 +197,11 @@ func (r *cloudNodeResource) Metadata(_ context.Context, req resource.MetadataReq
 // Schema defines the schema for the resource.
 func (r *cloudNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 2,
 		MarkdownDescription: `Manages a cloud node resource.
 Cloud node resource allows you to create, modify and delete Autonomi cloud nodes.
-Autonomi cloud node offers easy connection to cloud providers (AWS, Azure, GCP).`,
+Autonomi cloud node offers easy connection to cloud providers (AWS, Azure, GCP).
+Exactly one of the ` + "`aws`, `azure` or `gcp`" + ` blocks must be set, matching the cloud provider of the product.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "ID of the cloud node, set after creation",
@@ -100,97 +211,213 @@ Autonomi cloud node offers easy connection to cloud providers (AWS, Azure, GCP).
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the cloud node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the cloud node",
 				Computed:            true,
 			},
 			"deployed_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Deployment date of the cloud node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the workspace to which the cloud node belongs.",
 				Required:            true,
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the cloud node",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the cloud node [creation_pending, creation_proceed, creation_error,
 deployed, delete_pending, delete_proceed, delete_error]`,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Type of the node [cloud]",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"product": schema.SingleNestedAttribute{
-				Required: true,
-				Attributes: map[string]schema.Attribute{
-					"sku": schema.StringAttribute{
-						MarkdownDescription: "ID of the product",
-						Required:            true,
-					},
+			"product": productAttribute(),
+			"vlan": schema.Int64Attribute{
+				MarkdownDescription: "Vlan of the cloud node",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"provider_config": schema.SingleNestedAttribute{
-				Required: true, // only for cloud nodes
+			"deletion_protection": deletionProtectionAttribute("cloud node"),
+		},
+		Blocks: map[string]schema.Block{
+			"aws": schema.SingleNestedBlock{
+				MarkdownDescription: "AWS configuration of the cloud node, for products whose cspName is AWS",
+				// attributes of an optional block cannot be marked as required
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("account_id")),
+				},
 				Attributes: map[string]schema.Attribute{
-					"aws_account_id": schema.StringAttribute{
-						MarkdownDescription: "AWS Account ID where the resource will be created",
+					"account_id": schema.StringAttribute{
+						MarkdownDescription: "AWS Account ID where the resource will be created. Required.",
 						Optional:            true,
-						Default:             nil,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
+						Validators: []validator.String{
+							awsAccountIDValidator(),
 						},
 					},
-					"gcp_pairing_key": schema.StringAttribute{
-						MarkdownDescription: "GCP Pairing Key where the resource will be created",
-						Optional:            true,
-						Default:             nil,
+					"connection_id": schema.StringAttribute{
+						MarkdownDescription: "Connection ID created and returned by AWS",
+						Computed:            true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
-					"azure_service_key": schema.StringAttribute{
-						MarkdownDescription: "Azure Service Key where the resource will be created",
-						Optional:            true,
-						Default:             nil,
+					"dxcon_id": schema.StringAttribute{
+						MarkdownDescription: "Dxcon ID created and returned by AWS",
+						Computed:            true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
 				},
 			},
-			"connection_id": schema.StringAttribute{
-				MarkdownDescription: "Connection ID created and returned by the cloud provider",
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+			"azure": schema.SingleNestedBlock{
+				MarkdownDescription: "Azure configuration of the cloud node, for products whose cspName is Azure",
+				Attributes: map[string]schema.Attribute{
+					"service_key": schema.StringAttribute{
+						MarkdownDescription: "Azure Service Key of the ExpressRoute circuit. Conflicts with `service_key_wo`.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("service_key_wo")),
+						},
+					},
+					"service_key_wo": schema.StringAttribute{
+						MarkdownDescription: `Write-only Azure Service Key of the ExpressRoute circuit, never stored in the state. Requires Terraform 1.11 or later.
+Conflicts with ` + "`service_key`" + `. Since its changes cannot be detected, change ` + "`service_key_wo_version`" + ` to use a new key.`,
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+					},
+					"service_key_wo_version": schema.Int64Attribute{
+						MarkdownDescription: "Version of `service_key_wo`. Changing it forces the replacement of the cloud node with the current `service_key_wo`.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("service_key_wo")),
+						},
+					},
 				},
 			},
-			"vlan": schema.Int64Attribute{
-				MarkdownDescription: "Vlan of the cloud node",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+			"gcp": schema.SingleNestedBlock{
+				MarkdownDescription: "GCP configuration of the cloud node, for products whose cspName is GCP",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("pairing_key")),
 				},
-			},
-			"dxcon_id": schema.StringAttribute{
-				MarkdownDescription: "Dxcon ID created and returned by AWS",
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Attributes: map[string]schema.Attribute{
+					"pairing_key": schema.StringAttribute{
+						MarkdownDescription: "GCP Pairing Key of the partner interconnect attachment. Required.",
+						Optional:            true,
+						Sensitive:           true,
+					},
 				},
 			},
 		},
 	}
 }
 
+// ConfigValidators ensures exactly one cloud provider block is set.
+func (r *cloudNodeResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("aws"),
+			path.MatchRoot("azure"),
+			path.MatchRoot("gcp"),
+		),
+	}
+}
+
+// ValidateConfig ensures the azure block has a service key, either stored in the state or write-only.
+func (r *cloudNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var azure types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("azure"), &azure)...)
+	if resp.Diagnostics.HasError() || azure.IsNull() || azure.IsUnknown() {
+		return
+	}
+
+	var serviceKey, serviceKeyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("azure").AtName("service_key"), &serviceKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("azure").AtName("service_key_wo"), &serviceKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceKey.IsNull() && serviceKeyWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("azure"),
+			"Missing Azure service key",
+			"One of service_key or service_key_wo must be set in the azure block.",
+		)
+	}
+}
+
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *cloudNodeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at", "deployed_at")
+			setDefault(rawState, "deletion_protection", false)
+			upgradeCloudNodeProviderConfig(rawState)
+		}),
+		// version 1 stored the cloud provider configuration in the flat provider_config attribute
+		1: rawStateUpgrader(upgradeCloudNodeProviderConfig),
+	}
+}
+
+// upgradeCloudNodeProviderConfig moves the flat `provider_config` attribute, and the AWS connection
+// attributes, into the block of the cloud provider set.
+func upgradeCloudNodeProviderConfig(rawState map[string]any) {
+	config, _ := rawState["provider_config"].(map[string]any)
+	connectionID, dxconID := rawState["connection_id"], rawState["dxcon_id"]
+	delete(rawState, "provider_config")
+	delete(rawState, "connection_id")
+	delete(rawState, "dxcon_id")
+	rawState["aws"], rawState["azure"], rawState["gcp"] = nil, nil, nil
+
+	if value, _ := config["aws_account_id"].(string); value != "" {
+		rawState["aws"] = map[string]any{
+			"account_id":    value,
+			"connection_id": connectionID,
+			"dxcon_id":      dxconID,
+		}
+	} else if value, _ := config["azure_service_key"].(string); value != "" {
+		rawState["azure"] = map[string]any{"service_key": value}
+	} else if value, _ := config["gcp_pairing_key"].(string); value != "" {
+		rawState["gcp"] = map[string]any{"pairing_key": value}
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *cloudNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -201,6 +428,14 @@ func (r *cloudNodeResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Write-only values are only available in the configuration
+	var serviceKeyWO types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("azure").AtName("service_key_wo"), &serviceKeyWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
 	payload := models.CreateNode{
 		Name: plan.Name.ValueString(),
@@ -208,11 +443,7 @@ func (r *cloudNodeResource) Create(ctx context.Context, req resource.CreateReque
 		Product: models.AddProduct{
 			SKU: plan.Product.SKU.ValueString(),
 		},
-		ProviderConfig: &models.ProviderCloudConfig{
-			AccountID:  plan.ProviderConfig.AWSAccountID.ValueString(),
-			PairingKey: plan.ProviderConfig.GCPPairingKey.ValueString(),
-			ServiceKey: plan.ProviderConfig.AzureServiceKey.ValueString(),
-		},
+		ProviderConfig: plan.providerConfig(serviceKeyWO),
 	}
 
 	// Create new node
@@ -226,15 +457,8 @@ func (r *cloudNodeResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(node.ID.String())
-	plan.State = types.StringValue(node.State.String())
-	plan.Type = types.StringValue(node.Type.String())
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
-	plan.ConnectionID = types.StringValue(node.ConnectionID)
-	plan.Vlan = types.Int64Value(node.Vlan)
-	plan.DxconID = types.StringValue(node.DxconID)
+	plan.fromNode(node)
+	plan.Product = plannedProduct(plan.Product, node.Product)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -264,25 +488,8 @@ func (r *cloudNodeResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Overwrite items with refreshed state
-	state.ID = types.StringValue(node.ID.String())
-	state.CreatedAt = types.StringValue(node.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	state.DeployedAt = types.StringValue(node.DeployedAt.String())
-	state.Name = types.StringValue(node.Name)
-	state.State = types.StringValue(node.State.String())
-	state.Type = types.StringValue(node.Type.String())
-	state.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
-	state.ProviderConfig = providerCloudConfig{
-		AWSAccountID:    types.StringValue(node.ProviderConfig.AccountID),
-		GCPPairingKey:   types.StringValue(node.ProviderConfig.PairingKey),
-		AzureServiceKey: types.StringValue(node.ProviderConfig.ServiceKey),
-	}
-
-	state.ConnectionID = types.StringValue(node.ConnectionID)
-	state.Vlan = types.Int64Value(node.Vlan)
-	state.DxconID = types.StringValue(node.DxconID)
+	state.fromNode(node)
+	state.Product = productFromAPI(state.Product, node.Product)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -317,30 +524,8 @@ func (r *cloudNodeResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Update resource state with updated items and timestamp
-	plan.ID = types.StringValue(node.ID.String())
-	plan.Name = types.StringValue(node.Name)
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
-	plan.State = types.StringValue(node.State.String())
-	plan.Type = types.StringValue(node.Type.String())
-	plan.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
-
-	if node.ProviderConfig != nil {
-		if node.ProviderConfig.AccountID != "" {
-			plan.ProviderConfig.AWSAccountID = types.StringValue(node.ProviderConfig.AccountID)
-		} else if node.ProviderConfig.PairingKey != "" {
-			plan.ProviderConfig.GCPPairingKey = types.StringValue(node.ProviderConfig.PairingKey)
-		} else if node.ProviderConfig.ServiceKey != "" {
-			plan.ProviderConfig.AzureServiceKey = types.StringValue(node.ProviderConfig.ServiceKey)
-		}
-	}
-
-	plan.ConnectionID = types.StringValue(node.ConnectionID)
-	plan.Vlan = types.Int64Value(node.Vlan)
-	plan.DxconID = types.StringValue(node.DxconID)
+	plan.fromNode(node)
+	plan.Product = plannedProduct(plan.Product, node.Product)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -349,6 +534,57 @@ func (r *cloudNodeResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// ModifyPlan prevents the destruction or the replacement of a protected cloud node, rejects the changes that
+// cannot be made in place, warns when its commitment term is not over, checks the product is a cloud product
+// matching the cloud provider block, resolving its details, and keeps its workspace within budget.
+func (r *cloudNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "cloud node", cloudNodeReplacePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkImmutable(ctx, req, resp, "cloud node", cloudNodeImmutablePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkCommitmentTerm(ctx, req, resp, "cloud node", r.enforceCommitmentTerms, path.Root("deployed_at"), cloudNodeReplacePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cloudProduct productsmodels.CloudProduct
+	found := checkProductSKU(ctx, r.catalog, cloudProductIndex, req, resp, &cloudProduct, path.Root("aws"), path.Root("azure"), path.Root("gcp"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWorkspaceBudget(ctx, r.client, r.budget, r.prices, req, resp, "cloud node")
+	if !found || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the cloud provider block set, the ExactlyOneOf validator reporting any other case
+	block := ""
+	for name := range cloudNodeProviders {
+		var value types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() {
+			block = name
+		}
+	}
+	if resp.Diagnostics.HasError() || block == "" || strings.EqualFold(cloudProduct.CSPName, cloudNodeProviders[block]) {
+		return
+	}
+
+	detail := fmt.Sprintf("The product %s is a %s cloud product, it cannot be configured with the %s block.",
+		cloudProduct.SKU, cloudProduct.CSPName, block)
+	for name, cspName := range cloudNodeProviders {
+		if strings.EqualFold(cloudProduct.CSPName, cspName) {
+			detail += fmt.Sprintf(" Use the %s block instead.", name)
+		}
+	}
+	resp.Diagnostics.AddAttributeError(path.Root(block), "Cloud provider mismatch", detail)
+}
+
 func (r *cloudNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state cloudNodeResourceModel
@@ -358,6 +594,11 @@ func (r *cloudNodeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(deletionProtectionError("cloud node", state.ID.ValueString()))
+		return
+	}
+
 	// Delete existing node
 	_, err := r.client.DeleteNode(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString(), autonomisdk.WithWaitUntilElementUndeployed())
 	if err != nil {
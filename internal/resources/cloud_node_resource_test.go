@@ -0,0 +1,227 @@
+package autonomiresource
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudNodeFromNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		prior          cloudNodeResourceModel
+		providerConfig *models.ProviderCloudConfig
+		expect         cloudNodeResourceModel
+	}{
+		{
+			name: "aws",
+			prior: cloudNodeResourceModel{
+				AWS: &cloudNodeAWS{AccountID: types.StringValue("123456789012")},
+			},
+			providerConfig: &models.ProviderCloudConfig{AccountID: "123456789012"},
+			expect: cloudNodeResourceModel{
+				AWS: &cloudNodeAWS{
+					AccountID:    types.StringValue("123456789012"),
+					ConnectionID: types.StringValue("connection"),
+					DxconID:      types.StringValue("dxcon"),
+				},
+			},
+		},
+		{
+			name: "azure",
+			prior: cloudNodeResourceModel{
+				Azure: &cloudNodeAzure{ServiceKey: types.StringValue("service-key")},
+			},
+			providerConfig: &models.ProviderCloudConfig{ServiceKey: "service-key"},
+			expect: cloudNodeResourceModel{
+				Azure: &cloudNodeAzure{ServiceKey: types.StringValue("service-key")},
+			},
+		},
+		{
+			name: "gcp",
+			prior: cloudNodeResourceModel{
+				GCP: &cloudNodeGCP{PairingKey: types.StringValue("pairing-key/europe-west1/1")},
+			},
+			providerConfig: &models.ProviderCloudConfig{PairingKey: "pairing-key/europe-west1/1"},
+			expect: cloudNodeResourceModel{
+				GCP: &cloudNodeGCP{PairingKey: types.StringValue("pairing-key/europe-west1/1")},
+			},
+		},
+		{
+			name: "azure write-only service key is not stored",
+			prior: cloudNodeResourceModel{
+				Azure: &cloudNodeAzure{ServiceKeyWOVersion: types.Int64Value(1)},
+			},
+			providerConfig: &models.ProviderCloudConfig{ServiceKey: "service-key"},
+			expect: cloudNodeResourceModel{
+				Azure: &cloudNodeAzure{ServiceKeyWOVersion: types.Int64Value(1)},
+			},
+		},
+		{
+			name: "gcp pairing key echoed back masked",
+			prior: cloudNodeResourceModel{
+				GCP: &cloudNodeGCP{PairingKey: types.StringValue("pairing-key/europe-west1/1")},
+			},
+			providerConfig: &models.ProviderCloudConfig{PairingKey: "****"},
+			expect: cloudNodeResourceModel{
+				GCP: &cloudNodeGCP{PairingKey: types.StringValue("pairing-key/europe-west1/1")},
+			},
+		},
+		{
+			name:           "imported",
+			prior:          cloudNodeResourceModel{},
+			providerConfig: &models.ProviderCloudConfig{ServiceKey: "service-key"},
+			expect: cloudNodeResourceModel{
+				Azure: &cloudNodeAzure{ServiceKey: types.StringValue("service-key")},
+			},
+		},
+		{
+			name: "provider configuration not returned",
+			prior: cloudNodeResourceModel{
+				AWS: &cloudNodeAWS{AccountID: types.StringValue("123456789012")},
+			},
+			providerConfig: nil,
+			expect: cloudNodeResourceModel{
+				AWS: &cloudNodeAWS{
+					AccountID:    types.StringValue("123456789012"),
+					ConnectionID: types.StringValue("connection"),
+					DxconID:      types.StringValue("dxcon"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		node := &models.Node{}
+		node.ID = uuid.New()
+		node.Name = "cloud node"
+		node.ConnectionID = "connection"
+		node.DxconID = "dxcon"
+		node.ProviderConfig = tc.providerConfig
+
+		model := tc.prior
+		model.WorkspaceID = types.StringValue("workspace")
+		model.fromNode(node)
+
+		assert.Equal(t, tc.expect.AWS, model.AWS)
+		assert.Equal(t, tc.expect.Azure, model.Azure)
+		assert.Equal(t, tc.expect.GCP, model.GCP)
+		assert.Equal(t, node.ID.String(), model.ID.ValueString())
+		assert.Equal(t, "cloud node", model.Name.ValueString())
+		assert.Equal(t, "workspace", model.WorkspaceID.ValueString())
+		assert.True(t, model.DeployedAt.IsNull())
+	}
+}
+
+func TestCloudNodeProviderConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		model        cloudNodeResourceModel
+		serviceKeyWO types.String
+		expect       *models.ProviderCloudConfig
+	}{
+		{
+			name:   "aws",
+			model:  cloudNodeResourceModel{AWS: &cloudNodeAWS{AccountID: types.StringValue("123456789012")}},
+			expect: &models.ProviderCloudConfig{AccountID: "123456789012"},
+		},
+		{
+			name:   "azure",
+			model:  cloudNodeResourceModel{Azure: &cloudNodeAzure{ServiceKey: types.StringValue("service-key")}},
+			expect: &models.ProviderCloudConfig{ServiceKey: "service-key"},
+		},
+		{
+			name:         "azure write-only",
+			model:        cloudNodeResourceModel{Azure: &cloudNodeAzure{ServiceKeyWOVersion: types.Int64Value(1)}},
+			serviceKeyWO: types.StringValue("service-key-wo"),
+			expect:       &models.ProviderCloudConfig{ServiceKey: "service-key-wo"},
+		},
+		{
+			name:   "gcp",
+			model:  cloudNodeResourceModel{GCP: &cloudNodeGCP{PairingKey: types.StringValue("pairing-key")}},
+			expect: &models.ProviderCloudConfig{PairingKey: "pairing-key"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		assert.Equal(t, tc.expect, tc.model.providerConfig(tc.serviceKeyWO))
+	}
+}
+
+func TestUpgradeCloudNodeProviderConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawState map[string]any
+		expect   map[string]any
+	}{
+		{
+			name: "aws",
+			rawState: map[string]any{
+				"name": "node",
+				"provider_config": map[string]any{
+					"aws_account_id":    "123456789012",
+					"gcp_pairing_key":   nil,
+					"azure_service_key": nil,
+				},
+				"connection_id": "connection",
+				"dxcon_id":      "dxcon",
+			},
+			expect: map[string]any{
+				"name": "node",
+				"aws": map[string]any{
+					"account_id":    "123456789012",
+					"connection_id": "connection",
+					"dxcon_id":      "dxcon",
+				},
+				"azure": nil,
+				"gcp":   nil,
+			},
+		},
+		{
+			name: "azure stored with empty strings",
+			rawState: map[string]any{
+				"name": "node",
+				"provider_config": map[string]any{
+					"aws_account_id":    "",
+					"gcp_pairing_key":   "",
+					"azure_service_key": "service-key",
+				},
+				"connection_id": "",
+				"dxcon_id":      "",
+			},
+			expect: map[string]any{
+				"name":  "node",
+				"aws":   nil,
+				"azure": map[string]any{"service_key": "service-key"},
+				"gcp":   nil,
+			},
+		},
+		{
+			name: "gcp",
+			rawState: map[string]any{
+				"name": "node",
+				"provider_config": map[string]any{
+					"gcp_pairing_key": "pairing-key",
+				},
+			},
+			expect: map[string]any{
+				"name":  "node",
+				"aws":   nil,
+				"azure": nil,
+				"gcp":   map[string]any{"pairing_key": "pairing-key"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		upgradeCloudNodeProviderConfig(tc.rawState)
+		assert.Equal(t, tc.expect, tc.rawState)
+	}
+}
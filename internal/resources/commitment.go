@@ -0,0 +1,99 @@
+package autonomiresource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// now returns the current time, replaced in tests.
+var now = time.Now
+
+// commitmentTerm is the commitment of an element, running for the duration of its product from its start.
+type commitmentTerm struct {
+	start    time.Time
+	duration int64
+	priceMRC int64
+}
+
+// end returns the end of the commitment term.
+func (c commitmentTerm) end() time.Time {
+	return c.start.AddDate(0, int(c.duration), 0)
+}
+
+// remainingMonths returns the number of months left before the end of the commitment term at `t`,
+// a started month being counted as a whole one as it is billed.
+func (c commitmentTerm) remainingMonths(t time.Time) int64 {
+	end := c.end()
+	if !t.Before(end) {
+		return 0
+	}
+
+	months := int64(end.Year()-t.Year())*12 + int64(end.Month()-t.Month())
+	if t.AddDate(0, int(months), 0).Before(end) {
+		months++
+	}
+	for months > 0 && !t.AddDate(0, int(months-1), 0).Before(end) {
+		months--
+	}
+	return months
+}
+
+// checkCommitmentTerm warns when the plan destroys the element, or replaces it because one of the
+// `replacePaths` attributes changed, before the end of the commitment term of its product, starting at
+// the `termStart` attribute. The warning is raised as an error when `enforce` is set, i.e. when the
+// `enforce_commitment_terms` provider attribute is.
+func checkCommitmentTerm(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, element string, enforce bool, termStart path.Path, replacePaths ...path.Path) {
+	// no commitment on creation
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	action := "Destroying"
+	if !req.Plan.Raw.IsNull() {
+		p, replaced := replacingPath(ctx, req, resp, replacePaths...)
+		if !replaced {
+			return
+		}
+		action = fmt.Sprintf("Replacing, as %s changes,", p)
+	}
+
+	var start timetypes.RFC3339
+	var currentProduct product
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, termStart, &start)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("product"), &currentProduct)...)
+	if resp.Diagnostics.HasError() || start.IsNull() || start.IsUnknown() || currentProduct.Duration.ValueInt64() <= 0 {
+		return
+	}
+	startTime, diags := start.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term := commitmentTerm{
+		start:    startTime,
+		duration: currentProduct.Duration.ValueInt64(),
+		priceMRC: currentProduct.PriceMRC.ValueInt64(),
+	}
+	months := term.remainingMonths(now())
+	if months == 0 {
+		return
+	}
+
+	summary := "Commitment term not over"
+	detail := fmt.Sprintf("%s the %s ends its %d months commitment on the %s product before %s: %d months remain, "+
+		"an estimated early termination exposure of %d (remaining monthly recurring price).",
+		action, element, term.duration, currentProduct.SKU.ValueString(), term.end().Format(time.DateOnly),
+		months, months*term.priceMRC)
+	if enforce {
+		resp.Diagnostics.AddError(summary, detail+
+			" Set enforce_commitment_terms to false in the provider configuration to allow it.")
+		return
+	}
+	resp.Diagnostics.AddWarning(summary, detail)
+}
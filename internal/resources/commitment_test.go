@@ -0,0 +1,144 @@
+package autonomiresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitmentTermRemainingMonths(t *testing.T) {
+	term := commitmentTerm{
+		start:    time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+		duration: 12,
+	}
+
+	tests := []struct {
+		name   string
+		at     time.Time
+		expect int64
+	}{
+		{
+			name:   "term just started",
+			at:     time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expect: 12,
+		},
+		{
+			name:   "started month counted as a whole one",
+			at:     time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC),
+			expect: 8,
+		},
+		{
+			name:   "last days of the term",
+			at:     time.Date(2027, time.January, 30, 0, 0, 0, 0, time.UTC),
+			expect: 1,
+		},
+		{
+			name:   "term over",
+			at:     time.Date(2027, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expect: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, term.remainingMonths(tc.at))
+	}
+}
+
+func TestCheckCommitmentTerm(t *testing.T) {
+	defer func(n func() time.Time) { now = n }(now)
+	now = func() time.Time { return time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC) }
+
+	transport := func(sku string, deployedAt any) tftypes.Value {
+		value, _ := resourceValue(t, &transportResource{}, map[string]tftypes.Value{
+			"workspace_id":        tftypes.NewValue(tftypes.String, "workspace"),
+			"deployed_at":         tftypes.NewValue(tftypes.String, deployedAt),
+			"product.sku":         tftypes.NewValue(tftypes.String, sku),
+			"product.provider":    tftypes.NewValue(tftypes.String, "InterCloud"),
+			"product.location":    tftypes.NewValue(tftypes.String, "Paris"),
+			"product.location_to": tftypes.NewValue(tftypes.String, "Frankfurt"),
+			"product.bandwidth":   tftypes.NewValue(tftypes.Number, 100),
+			"product.duration":    tftypes.NewValue(tftypes.Number, 12),
+			"product.price_mrc":   tftypes.NewValue(tftypes.Number, 150),
+			"product.price_nrc":   tftypes.NewValue(tftypes.Number, 0),
+		})
+		return value
+	}
+	committed := transport("TRP-PAR-FRA-100", "2026-06-01T00:00:00Z")
+	_, schemaResp := resourceValue(t, &transportResource{}, nil)
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+
+	destroyDetail := "Destroying the transport ends its 12 months commitment on the TRP-PAR-FRA-100 product before 2027-06-01: " +
+		"8 months remain, an estimated early termination exposure of 1200 (remaining monthly recurring price)."
+
+	tests := []struct {
+		name    string
+		state   tftypes.Value
+		plan    tftypes.Value
+		enforce bool
+		expect  diag.Diagnostics
+	}{
+		{
+			name:  "creation",
+			state: destroyed,
+			plan:  committed,
+		},
+		{
+			name:  "update in place",
+			state: committed,
+			plan:  committed,
+		},
+		{
+			name:   "destruction",
+			state:  committed,
+			plan:   destroyed,
+			expect: diag.Diagnostics{diag.NewWarningDiagnostic("Commitment term not over", destroyDetail)},
+		},
+		{
+			name:    "enforced destruction",
+			state:   committed,
+			plan:    destroyed,
+			enforce: true,
+			expect: diag.Diagnostics{diag.NewErrorDiagnostic("Commitment term not over", destroyDetail+
+				" Set enforce_commitment_terms to false in the provider configuration to allow it.")},
+		},
+		{
+			name:  "replacement",
+			state: committed,
+			plan:  transport("TRP-PAR-FRA-1000", "2026-06-01T00:00:00Z"),
+			expect: diag.Diagnostics{diag.NewWarningDiagnostic("Commitment term not over",
+				"Replacing, as product.sku changes, the transport ends its 12 months commitment on the TRP-PAR-FRA-100 product before 2027-06-01: "+
+					"8 months remain, an estimated early termination exposure of 1200 (remaining monthly recurring price).")},
+		},
+		{
+			name:  "commitment term over",
+			state: transport("TRP-PAR-FRA-100", "2025-06-01T00:00:00Z"),
+			plan:  destroyed,
+		},
+		{
+			name:  "transport not deployed",
+			state: transport("TRP-PAR-FRA-100", nil),
+			plan:  destroyed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tc.plan},
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tc.state},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		checkCommitmentTerm(context.Background(), req, resp, "transport", tc.enforce, path.Root("deployed_at"), path.Root("product").AtName("sku"))
+		assert.Equal(t, tc.expect, resp.Diagnostics)
+	}
+}
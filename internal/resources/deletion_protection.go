@@ -0,0 +1,109 @@
+package autonomiresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the `deletion_protection` attribute shared by all billable elements.
+func deletionProtectionAttribute(element string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf(`Whether the %s is protected against deletion. Defaults to false.
+While set to true, any plan that would destroy or replace the %s fails.`, element, element),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection raises an error if the plan destroys the element, or replaces it because
+// one of the `replacePaths` attributes changed, while `deletion_protection` is set in the state.
+func checkDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, element string, replacePaths ...path.Path) {
+	// nothing to protect on creation
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Deletion protection enabled",
+			fmt.Sprintf("The %s cannot be destroyed while deletion_protection is set to true. "+
+				"Set deletion_protection to false and apply before destroying it.", element),
+		)
+		return
+	}
+
+	for _, p := range changedPaths(ctx, req, resp, replacePaths...) {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Deletion protection enabled",
+			fmt.Sprintf("Changing %s forces the replacement of the %s, which is not allowed while deletion_protection is set to true. "+
+				"Set deletion_protection to false and apply before replacing it.", p, element),
+		)
+	}
+}
+
+// checkImmutable raises an error for each of the `immutablePaths` attributes changed by the plan of an
+// existing element, as the API cannot update them in place.
+func checkImmutable(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, element string, immutablePaths ...path.Path) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, p := range changedPaths(ctx, req, resp, immutablePaths...) {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Attribute cannot be updated",
+			fmt.Sprintf("%s of the %s cannot be updated in place. Destroy the %s and create it again to change it, "+
+				"e.g. with terraform apply -replace.", p, element, element),
+		)
+	}
+}
+
+// replacingPath returns the first of the `replacePaths` attributes changed by the plan of an existing
+// element, forcing its replacement.
+func replacingPath(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, replacePaths ...path.Path) (path.Path, bool) {
+	changed := changedPaths(ctx, req, resp, replacePaths...)
+	if len(changed) == 0 {
+		return path.Empty(), false
+	}
+	return changed[0], true
+}
+
+// changedPaths returns the attributes among `paths` changed by the plan of an existing element. Attributes
+// whose planned value is unknown are not considered changed: the plan is made again once they are known.
+func changedPaths(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, paths ...path.Path) []path.Path {
+	var changed []path.Path
+	for _, p := range paths {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+		if !planValue.IsUnknown() && !planValue.Equal(stateValue) {
+			changed = append(changed, p)
+		}
+	}
+	return changed
+}
+
+// deletionProtectionError is raised by Delete when the element is still protected.
+func deletionProtectionError(element, id string) (string, string) {
+	return "Deletion protection enabled",
+		fmt.Sprintf("Could not delete %s %s: deletion_protection is set to true. "+
+			"Set deletion_protection to false and apply before destroying it.", element, id)
+}
@@ -0,0 +1,216 @@
+package autonomiresource
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// resourceValue returns a value of the schema of the resource, its attributes being null but the
+// `overrides`, keyed by attribute name. The attributes of a nested object are keyed by their dot separated
+// path, the object being set when one of them is overridden.
+func resourceValue(t *testing.T, r resource.Resource, overrides map[string]tftypes.Value) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return objectValue(objectType, "", overrides), schemaResp
+}
+
+// objectValue returns the object of the attributes prefixed by `prefix` in the `overrides` of resourceValue.
+func objectValue(objectType tftypes.Object, prefix string, overrides map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		key := prefix + name
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := overrides[key]; ok {
+			values[name] = value
+			continue
+		}
+		if nestedType, ok := attributeType.(tftypes.Object); ok {
+			for overridden := range overrides {
+				if strings.HasPrefix(overridden, key+".") {
+					values[name] = objectValue(nestedType, key+".", overrides)
+					break
+				}
+			}
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// stringValue returns the string, unknown when nil.
+func stringValue(value any) tftypes.Value {
+	if value == nil {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	return tftypes.NewValue(tftypes.String, value)
+}
+
+// transportValue returns a transport of the workspace with the product and the deletion protection, the
+// other attributes being null. A nil `sku` is unknown.
+func transportValue(t *testing.T, workspaceID string, sku any, protected bool) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	return resourceValue(t, &transportResource{}, map[string]tftypes.Value{
+		"workspace_id":        tftypes.NewValue(tftypes.String, workspaceID),
+		"product.sku":         stringValue(sku),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, protected),
+	})
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	protected, schemaResp := transportValue(t, "workspace", "TRP-100", true)
+	unprotected, _ := transportValue(t, "workspace", "TRP-100", false)
+	renewed, _ := transportValue(t, "workspace", "TRP-1000", true)
+	unknownSKU, _ := transportValue(t, "workspace", nil, true)
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+	skuPath := path.Root("product").AtName("sku")
+
+	tests := []struct {
+		name   string
+		state  tftypes.Value
+		plan   tftypes.Value
+		expect diag.Diagnostics
+	}{
+		{
+			name:  "creation",
+			state: destroyed,
+			plan:  protected,
+		},
+		{
+			name:  "unprotected destruction",
+			state: unprotected,
+			plan:  destroyed,
+		},
+		{
+			name:  "protected destruction",
+			state: protected,
+			plan:  destroyed,
+			expect: diag.Diagnostics{diag.NewErrorDiagnostic("Deletion protection enabled",
+				"The transport cannot be destroyed while deletion_protection is set to true. "+
+					"Set deletion_protection to false and apply before destroying it.")},
+		},
+		{
+			name:  "protected update",
+			state: protected,
+			plan:  protected,
+		},
+		{
+			name:  "protected replacement",
+			state: protected,
+			plan:  renewed,
+			expect: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(skuPath, "Deletion protection enabled",
+				"Changing product.sku forces the replacement of the transport, which is not allowed while deletion_protection is set to true. "+
+					"Set deletion_protection to false and apply before replacing it.")},
+		},
+		{
+			name:  "replacing value not known yet",
+			state: protected,
+			plan:  unknownSKU,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tc.plan},
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tc.state},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		checkDeletionProtection(context.Background(), req, resp, "transport", skuPath)
+		assert.Equal(t, tc.expect, resp.Diagnostics)
+	}
+}
+
+func TestCheckImmutable(t *testing.T) {
+	current, schemaResp := transportValue(t, "workspace", "TRP-100", false)
+	moved, _ := transportValue(t, "other-workspace", "TRP-1000", false)
+	unknownSKU, _ := transportValue(t, "workspace", nil, false)
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+
+	immutableError := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(p, "Attribute cannot be updated",
+			p.String()+" of the transport cannot be updated in place. Destroy the transport and create it again to change it, "+
+				"e.g. with terraform apply -replace.")
+	}
+
+	tests := []struct {
+		name   string
+		state  tftypes.Value
+		plan   tftypes.Value
+		expect diag.Diagnostics
+	}{
+		{
+			name:  "creation",
+			state: destroyed,
+			plan:  current,
+		},
+		{
+			name:  "destruction",
+			state: current,
+			plan:  destroyed,
+		},
+		{
+			name:  "unchanged",
+			state: current,
+			plan:  current,
+		},
+		{
+			name:   "changed",
+			state:  current,
+			plan:   moved,
+			expect: diag.Diagnostics{immutableError(path.Root("workspace_id")), immutableError(path.Root("product").AtName("sku"))},
+		},
+		{
+			name:  "value not known yet",
+			state: current,
+			plan:  unknownSKU,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tc.plan},
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tc.state},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		checkImmutable(context.Background(), req, resp, "transport", transportImmutablePaths...)
+		assert.Equal(t, tc.expect, resp.Diagnostics)
+	}
+}
+
+func TestDeletionProtectionDefault(t *testing.T) {
+	schemaResp := resource.SchemaResponse{}
+	(&transportResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	// unset, the deletion protection defaults to false
+	attribute, diags := schemaResp.Schema.AttributeAtPath(context.Background(), path.Root("deletion_protection"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, booldefault.StaticBool(false), attribute.(schema.BoolAttribute).Default)
+
+	// states prior to the deletion protection are upgraded unprotected
+	upgrader := (&transportResource{}).UpgradeState(context.Background())[0]
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"transport","deletion_protection":null}`)}}
+	resp := &resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(context.Background(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	upgraded := map[string]any{}
+	assert.NoError(t, json.Unmarshal(resp.DynamicValue.JSON, &upgraded))
+	assert.Equal(t, false, upgraded["deletion_protection"])
+}
@@ -0,0 +1,48 @@
+package autonomiresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var (
+	// deploymentPollInterval is the interval between two reads of an element waiting to be deployed.
+	deploymentPollInterval = 10 * time.Second
+	// deploymentTimeout bounds the wait for the deployment of an element.
+	deploymentTimeout = 30 * time.Minute
+)
+
+// waitUntilNodeDeployed reads the node until it is deployed, for creations submitted without waiting for
+// their deployment, e.g. to unlock the physical port of an access node before it is deployed. It fails
+// when the node is not deployed within the deployment timeout.
+func waitUntilNodeDeployed(ctx context.Context, client *autonomisdk.Client, workspaceID, nodeID string) (*models.Node, error) {
+	ctx, cancel := context.WithTimeout(ctx, deploymentTimeout)
+	defer cancel()
+
+	for {
+		node, err := client.GetNode(ctx, workspaceID, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		switch node.State {
+		case models.AdministrativeStateDeployed:
+			return node, nil
+		case models.AdministrativeStateCreationError:
+			return nil, fmt.Errorf("node %s is in state %s", nodeID, node.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("node %s is not deployed after %s, it is in state %s", nodeID, deploymentTimeout, node.State)
+			}
+			return nil, ctx.Err()
+		case <-time.After(deploymentPollInterval):
+		}
+	}
+}
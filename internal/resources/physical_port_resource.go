@@ -5,15 +5,21 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 const (
@@ -22,28 +28,39 @@ const (
 
 // physicalPortResource is the resource implementation.
 type physicalPortResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	// enforceCommitmentTerms raises commitment term warnings as errors
+	enforceCommitmentTerms bool
 }
 
 type physicalPortResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	AccountID          types.String `tfsdk:"account_id"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
-	Name               types.String `tfsdk:"name"`
-	State              types.String `tfsdk:"administrative_state"`
-	Product            product      `tfsdk:"product"`
-	AvailableBandwidth types.Int64  `tfsdk:"available_bandwidth"`
-	UsedVLANs          types.List   `tfsdk:"used_vlans"`
-	LOAAccessURL       types.String `tfsdk:"loa_access_url"`
+	ID                 types.String      `tfsdk:"id"`
+	AccountID          types.String      `tfsdk:"account_id"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt          timetypes.RFC3339 `tfsdk:"updated_at"`
+	Name               types.String      `tfsdk:"name"`
+	State              types.String      `tfsdk:"administrative_state"`
+	Product            product           `tfsdk:"product"`
+	AvailableBandwidth types.Int64       `tfsdk:"available_bandwidth"`
+	UsedVLANs          types.List        `tfsdk:"used_vlans"`
+	LOAAccessURL       types.String      `tfsdk:"loa_access_url"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &physicalPortResource{}
-	_ resource.ResourceWithConfigure = &physicalPortResource{}
+	_ resource.Resource                 = &physicalPortResource{}
+	_ resource.ResourceWithConfigure    = &physicalPortResource{}
+	_ resource.ResourceWithUpgradeState = &physicalPortResource{}
+	_ resource.ResourceWithModifyPlan   = &physicalPortResource{}
 )
 
+// physicalPortImmutablePaths lists the attributes that cannot be updated in place.
+var physicalPortImmutablePaths = []path.Path{
+	path.Root("product").AtName("sku"),
+}
+
 // NewPhysicalPortResource is a helper function to simplify the provider implementation.
 func NewPhysicalPortResource() resource.Resource {
 	return &physicalPortResource{}
@@ -57,18 +74,20 @@ func (r *physicalPortResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.enforceCommitmentTerms = clients.EnforceCommitmentTerms
 }
 
 // Metadata returns the resource type name.
@@ -79,6 +98,7 @@ func (r *physicalPortResource) Metadata(_ context.Context, req resource.Metadata
 // Schema defines the schema for the resource.
 func (r *physicalPortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages a physical port resource.
 Physical port resource allows you to create and delete Autonomi physical ports.
 Autonomi physical port are shared Autonomi connection instance.
@@ -92,10 +112,15 @@ They allow you to connect InterCloud back bone through access node.`,
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the physical port",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the physical port",
 				Computed:            true,
 			},
@@ -109,37 +134,54 @@ They allow you to connect InterCloud back bone through access node.`,
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the physical port",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the physical port [created, deleted]`,
 				Computed:            true,
-			},
-			"product": schema.SingleNestedAttribute{
-				Required: true,
-				Attributes: map[string]schema.Attribute{
-					"sku": schema.StringAttribute{
-						MarkdownDescription: "ID of the product",
-						Required:            true,
-					},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"product": productAttribute(),
 			"available_bandwidth": schema.Int64Attribute{
 				MarkdownDescription: `Available bandwidth on the physical port`,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"used_vlans": schema.ListAttribute{
 				MarkdownDescription: `Vlan already attributed on the physical port`,
 				Computed:            true,
 				ElementType:         types.NumberType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"loa_access_url": schema.StringAttribute{
 				MarkdownDescription: `URL to the physical port page where the LOA is downloadable`,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"deletion_protection": deletionProtectionAttribute("physical port"),
 		},
 	}
 }
 
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *physicalPortResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at")
+			setDefault(rawState, "deletion_protection", false)
+		}),
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *physicalPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -172,8 +214,9 @@ func (r *physicalPortResource) Create(ctx context.Context, req resource.CreateRe
 	plan.ID = types.StringValue(physicalPort.ID.String())
 	plan.AccountID = types.StringValue(physicalPort.AccountID)
 	plan.State = types.StringValue(physicalPort.State.String())
-	plan.CreatedAt = types.StringValue(physicalPort.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(physicalPort.UpdatedAt.String())
+	plan.CreatedAt = timestampValue(physicalPort.CreatedAt)
+	plan.UpdatedAt = timestampValue(physicalPort.UpdatedAt)
+	plan.Product = plannedProduct(plan.Product, physicalPort.Product)
 	plan.AvailableBandwidth = types.Int64Value(int64(physicalPort.AvailableBandwidth))
 	plan.UsedVLANs = types.ListValueMust(types.NumberType, convertInt64ArrayToNumberValues(physicalPort.UsedVLANs))
 	plan.LOAAccessURL = types.StringValue(physicalPort.LOAAccessURL)
@@ -206,13 +249,11 @@ func (r *physicalPortResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(physicalPort.ID.String())
-	state.CreatedAt = types.StringValue(physicalPort.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(physicalPort.UpdatedAt.String())
+	state.CreatedAt = timestampValue(physicalPort.CreatedAt)
+	state.UpdatedAt = timestampValue(physicalPort.UpdatedAt)
 	state.Name = types.StringValue(physicalPort.Name)
 	state.State = types.StringValue(physicalPort.State.String())
-	state.Product = product{
-		SKU: types.StringValue(physicalPort.Product.SKU),
-	}
+	state.Product = productFromAPI(state.Product, physicalPort.Product)
 	state.AccountID = types.StringValue(physicalPort.AccountID)
 	state.AvailableBandwidth = types.Int64Value(int64(physicalPort.AvailableBandwidth))
 	state.UsedVLANs = types.ListValueMust(types.NumberType, convertInt64ArrayToNumberValues(physicalPort.UsedVLANs))
@@ -226,6 +267,41 @@ func (r *physicalPortResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *physicalPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state physicalPortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Physical ports cannot be updated through the API, only the deletion protection is stored
+	state.DeletionProtection = plan.DeletionProtection
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan prevents the destruction of a protected physical port, rejects the changes that cannot be made in place,
+// warns when its commitment term is not over, and checks the product is a physical port product, resolving its details.
+func (r *physicalPortResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "physical port")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkImmutable(ctx, req, resp, "physical port", physicalPortImmutablePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkCommitmentTerm(ctx, req, resp, "physical port", r.enforceCommitmentTerms, path.Root("created_at"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkProductSKU(ctx, r.catalog, physicalPortProductIndex, req, resp, nil)
 }
 
 func (r *physicalPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -237,6 +313,11 @@ func (r *physicalPortResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(deletionProtectionError("physical port", state.ID.ValueString()))
+		return
+	}
+
 	// Delete existing physical port
 	err := r.client.DeletePhysicalPort(ctx, state.ID.ValueString())
 	if err != nil {
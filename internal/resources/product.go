@@ -0,0 +1,129 @@
+package autonomiresource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+)
+
+// product is the product of an element, its details being resolved from the catalog by SKU.
+type product struct {
+	SKU        types.String `tfsdk:"sku"`
+	Provider   types.String `tfsdk:"provider"`
+	Location   types.String `tfsdk:"location"`
+	LocationTo types.String `tfsdk:"location_to"`
+	Bandwidth  types.Int64  `tfsdk:"bandwidth"`
+	Duration   types.Int64  `tfsdk:"duration"`
+	PriceMRC   types.Int64  `tfsdk:"price_mrc"`
+	PriceNRC   types.Int64  `tfsdk:"price_nrc"`
+}
+
+// productAttribute is the `product` attribute of the resources, its SKU being the only configurable attribute.
+func productAttribute() schema.SingleNestedAttribute {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	computedInt64 := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Product of the element, its details being resolved from the catalog by SKU",
+		Required:            true,
+		Attributes: map[string]schema.Attribute{
+			"sku": schema.StringAttribute{
+				MarkdownDescription: "ID of the product. It cannot be updated in place: destroy the element and create it again to change it",
+				Required:            true,
+			},
+			"provider":    computedString("Provider of the product"),
+			"location":    computedString("Location of the product"),
+			"location_to": computedString("Destination location of the product, set for transport products only"),
+			"bandwidth":   computedInt64("Bandwidth of the product, in Mbps"),
+			"duration":    computedInt64("Commitment duration of the product, in months"),
+			"price_mrc":   computedInt64("Monthly recurring price of the product"),
+			"price_nrc":   computedInt64("Non-recurring price of the product"),
+		},
+	}
+}
+
+// productFromCatalog maps the catalog product onto the `product` attribute. The destination location is only
+// listed for transport products, it is left null and set by the transport resource from its product model.
+func productFromCatalog(catalogProduct productsmodels.Product) product {
+	return product{
+		SKU:        types.StringValue(catalogProduct.SKU),
+		Provider:   types.StringValue(catalogProduct.Provider),
+		Location:   types.StringValue(catalogProduct.Location),
+		LocationTo: types.StringNull(),
+		Bandwidth:  types.Int64Value(int64(catalogProduct.Bandwidth)),
+		Duration:   types.Int64Value(int64(catalogProduct.Duration)),
+		PriceMRC:   types.Int64Value(int64(catalogProduct.PriceMRC)),
+		PriceNRC:   types.Int64Value(int64(catalogProduct.PriceNRC)),
+	}
+}
+
+// productFromAPI maps the product returned by the API onto the `product` attribute on refresh, so the
+// prices and the duration follow the ones of the API. The API not returning the destination location, it is
+// kept from the prior value for the same SKU.
+func productFromAPI(prior product, apiProduct models.Product) product {
+	locationTo := prior.LocationTo
+	if locationTo.IsUnknown() || prior.SKU.ValueString() != apiProduct.SKU {
+		locationTo = types.StringNull()
+	}
+	return product{
+		SKU:        types.StringValue(apiProduct.SKU),
+		Provider:   types.StringValue(apiProduct.Provider.String()),
+		Location:   types.StringValue(apiProduct.Location),
+		LocationTo: locationTo,
+		Bandwidth:  types.Int64Value(int64(apiProduct.Bandwidth)),
+		Duration:   types.Int64Value(int64(apiProduct.Duration)),
+		PriceMRC:   types.Int64Value(int64(apiProduct.PriceMRC)),
+		PriceNRC:   types.Int64Value(int64(apiProduct.PriceNRC)),
+	}
+}
+
+// plannedProduct returns the product of the element once created or updated. The details known at plan
+// time are kept, so the state matches the plan whatever the differences between the catalog and the API,
+// the others being set from the product returned by the API. They follow the API on refresh.
+func plannedProduct(planned product, apiProduct models.Product) product {
+	fromAPI := productFromAPI(planned, apiProduct)
+	knownString := func(planned, fromAPI types.String) types.String {
+		if planned.IsUnknown() {
+			return fromAPI
+		}
+		return planned
+	}
+	knownInt64 := func(planned, fromAPI types.Int64) types.Int64 {
+		if planned.IsUnknown() {
+			return fromAPI
+		}
+		return planned
+	}
+
+	return product{
+		SKU:        knownString(planned.SKU, fromAPI.SKU),
+		Provider:   knownString(planned.Provider, fromAPI.Provider),
+		Location:   knownString(planned.Location, fromAPI.Location),
+		LocationTo: knownString(planned.LocationTo, fromAPI.LocationTo),
+		Bandwidth:  knownInt64(planned.Bandwidth, fromAPI.Bandwidth),
+		Duration:   knownInt64(planned.Duration, fromAPI.Duration),
+		PriceMRC:   knownInt64(planned.PriceMRC, fromAPI.PriceMRC),
+		PriceNRC:   knownInt64(planned.PriceNRC, fromAPI.PriceNRC),
+	}
+}
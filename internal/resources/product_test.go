@@ -0,0 +1,250 @@
+package autonomiresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/intercloud/autonomi-sdk/models"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var transportProduct = product{
+	SKU:        types.StringValue("TRP-PAR-FRA-100"),
+	Provider:   types.StringValue("InterCloud"),
+	Location:   types.StringValue("Paris"),
+	LocationTo: types.StringValue("Frankfurt"),
+	Bandwidth:  types.Int64Value(100),
+	Duration:   types.Int64Value(12),
+	PriceMRC:   types.Int64Value(150),
+	PriceNRC:   types.Int64Value(0),
+}
+
+func TestProductFromCatalog(t *testing.T) {
+	tests := []struct {
+		name           string
+		catalogProduct productsmodels.Product
+		expect         product
+	}{
+		{
+			name: "transport product, its destination location being set by the transport resource",
+			catalogProduct: productsmodels.Product{
+				Provider:  "InterCloud",
+				Location:  "Paris",
+				Bandwidth: 100,
+				Duration:  12,
+				PriceMRC:  150,
+				SKU:       "TRP-PAR-FRA-100",
+			},
+			expect: func() product {
+				p := transportProduct
+				p.LocationTo = types.StringNull()
+				return p
+			}(),
+		},
+		{
+			name: "access product",
+			catalogProduct: productsmodels.Product{
+				Provider:  "InterCloud",
+				Location:  "Paris",
+				Bandwidth: 1000,
+				Duration:  1,
+				PriceMRC:  300,
+				PriceNRC:  500,
+				SKU:       "ICL-PAR-1G",
+			},
+			expect: product{
+				SKU:        types.StringValue("ICL-PAR-1G"),
+				Provider:   types.StringValue("InterCloud"),
+				Location:   types.StringValue("Paris"),
+				LocationTo: types.StringNull(),
+				Bandwidth:  types.Int64Value(1000),
+				Duration:   types.Int64Value(1),
+				PriceMRC:   types.Int64Value(300),
+				PriceNRC:   types.Int64Value(500),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, productFromCatalog(tc.catalogProduct))
+	}
+}
+
+func TestTransportProductDetails(t *testing.T) {
+	client := newTestCatalog(t, map[string][]map[string]any{
+		"transportproduct": {
+			{
+				"sku":        "TRP-PAR-FRA-100",
+				"provider":   "InterCloud",
+				"location":   "Paris",
+				"locationTo": "Frankfurt",
+				"bandwidth":  100,
+				"duration":   12,
+				"priceMrc":   150,
+			},
+		},
+	})
+	planned, schemaResp := transportValue(t, "workspace", "TRP-PAR-FRA-100", false)
+	destroyed := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: destroyed},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	(&transportResource{catalog: client}).ModifyPlan(context.Background(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	var plannedProduct product
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("product"), &plannedProduct)...)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, transportProduct, plannedProduct)
+}
+
+func TestProductFromAPI(t *testing.T) {
+	apiProduct := models.Product{
+		Provider:  "InterCloud",
+		Location:  "Paris",
+		Bandwidth: 100,
+		Duration:  12,
+		PriceMRC:  200,
+		SKU:       "TRP-PAR-FRA-100",
+	}
+	apiDetails := product{
+		SKU:        types.StringValue("TRP-PAR-FRA-100"),
+		Provider:   types.StringValue("InterCloud"),
+		Location:   types.StringValue("Paris"),
+		LocationTo: types.StringNull(),
+		Bandwidth:  types.Int64Value(100),
+		Duration:   types.Int64Value(12),
+		PriceMRC:   types.Int64Value(200),
+		PriceNRC:   types.Int64Value(0),
+	}
+
+	tests := []struct {
+		name   string
+		prior  product
+		expect product
+	}{
+		{
+			name:  "prices and duration refreshed from the API, destination location kept",
+			prior: transportProduct,
+			expect: func() product {
+				p := apiDetails
+				p.LocationTo = types.StringValue("Frankfurt")
+				return p
+			}(),
+		},
+		{
+			name: "details not resolved at plan time",
+			prior: product{
+				SKU:        types.StringValue("TRP-PAR-FRA-100"),
+				Provider:   types.StringUnknown(),
+				Location:   types.StringUnknown(),
+				LocationTo: types.StringUnknown(),
+				Bandwidth:  types.Int64Unknown(),
+				Duration:   types.Int64Unknown(),
+				PriceMRC:   types.Int64Unknown(),
+				PriceNRC:   types.Int64Unknown(),
+			},
+			expect: apiDetails,
+		},
+		{
+			name:   "imported element",
+			prior:  product{},
+			expect: apiDetails,
+		},
+		{
+			name: "state without details keeps the destination location",
+			prior: product{
+				SKU:        types.StringValue("TRP-PAR-FRA-100"),
+				LocationTo: types.StringValue("Frankfurt"),
+			},
+			expect: func() product {
+				p := apiDetails
+				p.LocationTo = types.StringValue("Frankfurt")
+				return p
+			}(),
+		},
+		{
+			name: "product changed outside of Terraform",
+			prior: product{
+				SKU:        types.StringValue("TRP-PAR-LON-100"),
+				Provider:   types.StringValue("InterCloud"),
+				Location:   types.StringValue("Paris"),
+				LocationTo: types.StringValue("London"),
+				Bandwidth:  types.Int64Value(100),
+				Duration:   types.Int64Value(12),
+				PriceMRC:   types.Int64Value(150),
+				PriceNRC:   types.Int64Value(0),
+			},
+			expect: apiDetails,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, productFromAPI(tc.prior, apiProduct))
+	}
+}
+
+func TestPlannedProduct(t *testing.T) {
+	apiProduct := models.Product{
+		Provider:  "InterCloud",
+		Location:  "Paris",
+		Bandwidth: 100,
+		Duration:  12,
+		PriceMRC:  200,
+		SKU:       "TRP-PAR-FRA-100",
+	}
+
+	tests := []struct {
+		name    string
+		planned product
+		expect  product
+	}{
+		{
+			name:    "details resolved from the catalog at plan time kept",
+			planned: transportProduct,
+			expect:  transportProduct,
+		},
+		{
+			name: "details not resolved at plan time",
+			planned: product{
+				SKU:        types.StringValue("TRP-PAR-FRA-100"),
+				Provider:   types.StringUnknown(),
+				Location:   types.StringUnknown(),
+				LocationTo: types.StringUnknown(),
+				Bandwidth:  types.Int64Unknown(),
+				Duration:   types.Int64Unknown(),
+				PriceMRC:   types.Int64Unknown(),
+				PriceNRC:   types.Int64Unknown(),
+			},
+			expect: product{
+				SKU:        types.StringValue("TRP-PAR-FRA-100"),
+				Provider:   types.StringValue("InterCloud"),
+				Location:   types.StringValue("Paris"),
+				LocationTo: types.StringNull(),
+				Bandwidth:  types.Int64Value(100),
+				Duration:   types.Int64Value(12),
+				PriceMRC:   types.Int64Value(200),
+				PriceNRC:   types.Int64Value(0),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		assert.Equal(t, tc.expect, plannedProduct(tc.planned, apiProduct))
+	}
+}
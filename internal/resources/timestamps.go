@@ -0,0 +1,112 @@
+package autonomiresource
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// goTimeLayout is the layout of `time.Time.String()`, used to store timestamps before schema version 1.
+const goTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+// timestampValue converts an API timestamp into an RFC 3339 value.
+// A zero timestamp (e.g. `deployed_at` of an element not deployed yet) is converted to null.
+func timestampValue(t time.Time) timetypes.RFC3339 {
+	if t.IsZero() {
+		return timetypes.NewRFC3339Null()
+	}
+	return timetypes.NewRFC3339TimeValue(t)
+}
+
+// rawStateUpgrader builds a state upgrader working on the raw JSON state, so the prior schema does not
+// have to be kept around. `upgrade` modifies the decoded state in place to match the current schema.
+func rawStateUpgrader(upgrade func(rawState map[string]any)) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The prior state is not stored as JSON. Please report this issue to the provider developers.",
+				)
+				return
+			}
+
+			rawState := map[string]any{}
+			if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"Could not decode the prior state, unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			upgrade(rawState)
+
+			upgradedJSON, err := json.Marshal(rawState)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"Could not encode the upgraded state, unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedJSON}
+		},
+	}
+}
+
+// upgradeTimestamps converts the timestamps stored with `time.Time.String()` into RFC 3339 timestamps.
+// Keys of nested attributes are dot separated (e.g. `service_key.expiration_date`).
+func upgradeTimestamps(rawState map[string]any, keys ...string) {
+	for _, key := range keys {
+		attributes := rawState
+		names := strings.Split(key, ".")
+		for _, name := range names[:len(names)-1] {
+			nested, ok := attributes[name].(map[string]any)
+			if !ok {
+				attributes = nil
+				break
+			}
+			attributes = nested
+		}
+		if attributes == nil {
+			continue
+		}
+
+		name := names[len(names)-1]
+		value, ok := attributes[name].(string)
+		if !ok {
+			continue
+		}
+		attributes[name] = upgradeTimestamp(value)
+	}
+}
+
+// upgradeTimestamp converts a `time.Time.String()` timestamp into an RFC 3339 one, nil being returned
+// for zero or unparsable timestamps. Timestamps already in RFC 3339 are kept as is.
+func upgradeTimestamp(value string) any {
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value
+	}
+
+	// strip the monotonic clock reading, if any
+	value, _, _ = strings.Cut(value, " m=")
+	t, err := time.Parse(goTimeLayout, value)
+	if err != nil || t.IsZero() {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
+
+// setDefault sets the attribute to its default value when it is missing from the prior state.
+func setDefault(rawState map[string]any, key string, value any) {
+	if v, ok := rawState[key]; !ok || v == nil {
+		rawState[key] = value
+	}
+}
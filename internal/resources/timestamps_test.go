@@ -0,0 +1,97 @@
+package autonomiresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampValue(t *testing.T) {
+	assert.True(t, timestampValue(time.Time{}).IsNull())
+
+	deployedAt := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2024-07-01T10:00:00Z", timestampValue(deployedAt).ValueString())
+}
+
+func TestUpgradeTimestamps(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawState map[string]any
+		expect   map[string]any
+	}{
+		{
+			name: "go formatted timestamps",
+			rawState: map[string]any{
+				"created_at":  "2024-07-01 10:00:00 +0000 UTC",
+				"updated_at":  "2024-07-02 12:30:15.123456789 +0200 CEST",
+				"deployed_at": "2024-07-01 10:05:00 +0000 UTC m=+0.000000001",
+			},
+			expect: map[string]any{
+				"created_at":  "2024-07-01T10:00:00Z",
+				"updated_at":  "2024-07-02T12:30:15+02:00",
+				"deployed_at": "2024-07-01T10:05:00Z",
+			},
+		},
+		{
+			name: "zero timestamp is null",
+			rawState: map[string]any{
+				"created_at":  "2024-07-01 10:00:00 +0000 UTC",
+				"updated_at":  "2024-07-01 10:00:00 +0000 UTC",
+				"deployed_at": "0001-01-01 00:00:00 +0000 UTC",
+			},
+			expect: map[string]any{
+				"created_at":  "2024-07-01T10:00:00Z",
+				"updated_at":  "2024-07-01T10:00:00Z",
+				"deployed_at": nil,
+			},
+		},
+		{
+			name: "rfc3339 and null timestamps are kept",
+			rawState: map[string]any{
+				"created_at":  "2024-07-01T10:00:00Z",
+				"updated_at":  "2024-07-01T10:00:00+02:00",
+				"deployed_at": nil,
+			},
+			expect: map[string]any{
+				"created_at":  "2024-07-01T10:00:00Z",
+				"updated_at":  "2024-07-01T10:00:00+02:00",
+				"deployed_at": nil,
+			},
+		},
+		{
+			name: "nested timestamp",
+			rawState: map[string]any{
+				"created_at": "2024-07-01 10:00:00 +0000 UTC",
+				"service_key": map[string]any{
+					"id":              "key",
+					"expiration_date": "2024-08-01 10:00:00 +0000 UTC",
+				},
+			},
+			expect: map[string]any{
+				"created_at": "2024-07-01T10:00:00Z",
+				"service_key": map[string]any{
+					"id":              "key",
+					"expiration_date": "2024-08-01T10:00:00Z",
+				},
+			},
+		},
+		{
+			name: "null nested object",
+			rawState: map[string]any{
+				"created_at":  "2024-07-01 10:00:00 +0000 UTC",
+				"service_key": nil,
+			},
+			expect: map[string]any{
+				"created_at":  "2024-07-01T10:00:00Z",
+				"service_key": nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		upgradeTimestamps(tc.rawState, "created_at", "updated_at", "deployed_at", "service_key.expiration_date")
+		assert.Equal(t, tc.expect, tc.rawState)
+	}
+}
@@ -4,21 +4,34 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	productsmodels "github.com/intercloud/terraform-provider-autonomi/external/products/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 // transportResource is the resource implementation.
 type transportResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	// enforceCommitmentTerms raises commitment term warnings as errors
+	enforceCommitmentTerms bool
+	budget                 *budget.Ledger
+	prices                 *budget.Prices
 }
 
 var transportVlans = map[string]attr.Type{
@@ -27,24 +40,33 @@ var transportVlans = map[string]attr.Type{
 }
 
 type transportResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	WorkspaceID  types.String `tfsdk:"workspace_id"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
-	DeployedAt   types.String `tfsdk:"deployed_at"`
-	Name         types.String `tfsdk:"name"`
-	State        types.String `tfsdk:"administrative_state"`
-	Product      product      `tfsdk:"product"`
-	Vlans        types.Object `tfsdk:"vlans"`
-	ConnectionID types.String `tfsdk:"connection_id"`
+	ID                 types.String      `tfsdk:"id"`
+	WorkspaceID        types.String      `tfsdk:"workspace_id"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt          timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt         timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name               types.String      `tfsdk:"name"`
+	State              types.String      `tfsdk:"administrative_state"`
+	Product            product           `tfsdk:"product"`
+	Vlans              types.Object      `tfsdk:"vlans"`
+	ConnectionID       types.String      `tfsdk:"connection_id"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &transportResource{}
-	_ resource.ResourceWithConfigure = &transportResource{}
+	_ resource.Resource                 = &transportResource{}
+	_ resource.ResourceWithConfigure    = &transportResource{}
+	_ resource.ResourceWithUpgradeState = &transportResource{}
+	_ resource.ResourceWithModifyPlan   = &transportResource{}
 )
 
+// transportImmutablePaths lists the attributes that cannot be updated in place.
+var transportImmutablePaths = []path.Path{
+	path.Root("workspace_id"),
+	path.Root("product").AtName("sku"),
+}
+
 // NewTransportResource is a helper function to simplify the provider implementation.
 func NewTransportResource() resource.Resource {
 	return &transportResource{}
@@ -58,18 +80,22 @@ func (r *transportResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.enforceCommitmentTerms = clients.EnforceCommitmentTerms
+	r.budget = clients.Budget
+	r.prices = clients.Prices
 }
 
 // Metadata returns the resource type name.
@@ -80,6 +106,7 @@ func (r *transportResource) Metadata(_ context.Context, req resource.MetadataReq
 // Schema defines the schema for the resource.
 func (r *transportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages a transport resource.
 Transport resource allows you to create, modify and delete Autonomi transports.
 Autonomi transport offers connection between Autonomi nodes (cloud nodes, access nodes).`,
@@ -92,6 +119,7 @@ Autonomi transport offers connection between Autonomi nodes (cloud nodes, access
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the transport",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -99,19 +127,24 @@ Autonomi transport offers connection between Autonomi nodes (cloud nodes, access
 				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the transport",
 				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			"deployed_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Deployment date of the transport",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the workspace to which the transport belongs.",
 				Required:            true,
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the transport",
@@ -119,6 +152,7 @@ Autonomi transport offers connection between Autonomi nodes (cloud nodes, access
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: nameValidators(),
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the transport [creation_pending, creation_proceed, creation_error,
@@ -128,19 +162,14 @@ deployed, delete_pending, delete_proceed, delete_error]`,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"product": schema.SingleNestedAttribute{
-				Required: true,
-				Attributes: map[string]schema.Attribute{
-					"sku": schema.StringAttribute{
-						MarkdownDescription: "ID of the product",
-						Required:            true,
-					},
-				},
-			},
+			"product": productAttribute(),
 			"vlans": schema.SingleNestedAttribute{
 				MarkdownDescription: "Vlans of the transport",
 				Default:             nil,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"a_vlan": schema.Int64Attribute{
 						MarkdownDescription: "vlan for A side",
@@ -165,10 +194,22 @@ deployed, delete_pending, delete_proceed, delete_error]`,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("transport"),
 		},
 	}
 }
 
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *transportResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at", "deployed_at")
+			setDefault(rawState, "deletion_protection", false)
+		}),
+	}
+}
+
 // Create transport creates the resource and sets the initial Terraform state.
 func (r *transportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -200,9 +241,10 @@ func (r *transportResource) Create(ctx context.Context, req resource.CreateReque
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(transport.ID.String())
 	plan.State = types.StringValue(transport.State.String())
-	plan.CreatedAt = types.StringValue(transport.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(transport.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(transport.DeployedAt.String())
+	plan.CreatedAt = timestampValue(transport.CreatedAt)
+	plan.UpdatedAt = timestampValue(transport.UpdatedAt)
+	plan.DeployedAt = timestampValue(transport.DeployedAt)
+	plan.Product = plannedProduct(plan.Product, transport.Product)
 	plan.ConnectionID = types.StringValue(transport.ConnectionID)
 
 	// set transportVlans object
@@ -250,14 +292,12 @@ func (r *transportResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(transport.ID.String())
-	state.CreatedAt = types.StringValue(transport.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(transport.UpdatedAt.String())
-	state.DeployedAt = types.StringValue(transport.DeployedAt.String())
+	state.CreatedAt = timestampValue(transport.CreatedAt)
+	state.UpdatedAt = timestampValue(transport.UpdatedAt)
+	state.DeployedAt = timestampValue(transport.DeployedAt)
 	state.Name = types.StringValue(transport.Name)
 	state.State = types.StringValue(transport.State.String())
-	state.Product = product{
-		SKU: types.StringValue(transport.Product.SKU),
-	}
+	state.Product = productFromAPI(state.Product, transport.Product)
 
 	state.ConnectionID = types.StringValue(transport.ConnectionID)
 	// set trnasportVlans object
@@ -311,13 +351,11 @@ func (r *transportResource) Update(ctx context.Context, req resource.UpdateReque
 	// Update resource state with updated items and timestamp
 	plan.ID = types.StringValue(transport.ID.String())
 	plan.Name = types.StringValue(transport.Name)
-	plan.CreatedAt = types.StringValue(transport.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(transport.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(transport.DeployedAt.String())
+	plan.CreatedAt = timestampValue(transport.CreatedAt)
+	plan.UpdatedAt = timestampValue(transport.UpdatedAt)
+	plan.DeployedAt = timestampValue(transport.DeployedAt)
 	plan.State = types.StringValue(transport.State.String())
-	plan.Product = product{
-		SKU: types.StringValue(transport.Product.SKU),
-	}
+	plan.Product = plannedProduct(plan.Product, transport.Product)
 	plan.ConnectionID = types.StringValue(transport.ConnectionID)
 
 	// set transportVlans object
@@ -343,6 +381,34 @@ func (r *transportResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// ModifyPlan prevents the destruction of a protected transport, rejects the changes that cannot be made in place,
+// warns when its commitment term is not over, checks the product is a transport product, resolving its details,
+// and keeps its workspace within budget.
+func (r *transportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "transport")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkImmutable(ctx, req, resp, "transport", transportImmutablePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkCommitmentTerm(ctx, req, resp, "transport", r.enforceCommitmentTerms, path.Root("deployed_at"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var transportProduct productsmodels.TransportProduct
+	if checkProductSKU(ctx, r.catalog, transportProductIndex, req, resp, &transportProduct) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("product").AtName("location_to"), optionalStringValue(types.StringNull(), transportProduct.LocationTo))...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWorkspaceBudget(ctx, r.client, r.budget, r.prices, req, resp, "transport")
+}
+
 func (r *transportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state transportResourceModel
@@ -352,6 +418,11 @@ func (r *transportResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(deletionProtectionError("transport", state.ID.ValueString()))
+		return
+	}
+
 	// Delete existing node
 	_, err := r.client.DeleteTransport(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString(), autonomisdk.WithWaitUntilElementUndeployed())
 	if err != nil {
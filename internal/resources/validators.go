@@ -0,0 +1,47 @@
+package autonomiresource
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/intercloud/terraform-provider-autonomi/internal/physicalport"
+)
+
+const (
+	minVlan = physicalport.MinVLAN
+	maxVlan = physicalport.MaxVLAN
+
+	maxNameLength = 255
+)
+
+var (
+	uuidRegexp         = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	nameRegexp         = regexp.MustCompile(`^[^\p{Cc}]*$`)
+	awsAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+)
+
+// uuidValidator validates the IDs of the Autonomi elements.
+func uuidValidator() validator.String {
+	return stringvalidator.RegexMatches(uuidRegexp, "must be a valid UUID")
+}
+
+// nameValidators validates the names of the Autonomi elements.
+func nameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxNameLength),
+		stringvalidator.RegexMatches(nameRegexp, "must not contain control characters"),
+	}
+}
+
+// vlanValidator validates the VLAN IDs.
+func vlanValidator() validator.Int64 {
+	return int64validator.Between(minVlan, maxVlan)
+}
+
+// awsAccountIDValidator validates the AWS account IDs, made of 12 digits.
+func awsAccountIDValidator() validator.String {
+	return stringvalidator.RegexMatches(awsAccountIDRegexp, "must be a 12-digit AWS account ID")
+}
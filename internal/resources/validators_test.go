@@ -0,0 +1,69 @@
+package autonomiresource
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateString(validators []validator.String, value types.String) bool {
+	for _, v := range validators {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: value}, resp)
+		if resp.Diagnostics.HasError() {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name       string
+		validators []validator.String
+		value      types.String
+		valid      bool
+	}{
+		{name: "uuid", validators: []validator.String{uuidValidator()}, value: types.StringValue("3f2a54e4-8d8e-4b4a-9b7c-0d5e4a3f2b1c"), valid: true},
+		{name: "uppercase uuid", validators: []validator.String{uuidValidator()}, value: types.StringValue("3F2A54E4-8D8E-4B4A-9B7C-0D5E4A3F2B1C"), valid: true},
+		{name: "truncated uuid", validators: []validator.String{uuidValidator()}, value: types.StringValue("3f2a54e4-8d8e-4b4a-9b7c-0d5e4a3f2b1"), valid: false},
+		{name: "not a uuid", validators: []validator.String{uuidValidator()}, value: types.StringValue("my-workspace"), valid: false},
+		{name: "unknown uuid", validators: []validator.String{uuidValidator()}, value: types.StringUnknown(), valid: true},
+		{name: "name", validators: nameValidators(), value: types.StringValue("Paris - AWS eu-west-3 (prod)"), valid: true},
+		{name: "empty name", validators: nameValidators(), value: types.StringValue(""), valid: false},
+		{name: "too long name", validators: nameValidators(), value: types.StringValue(strings.Repeat("a", maxNameLength+1)), valid: false},
+		{name: "name with a new line", validators: nameValidators(), value: types.StringValue("first\nsecond"), valid: false},
+		{name: "aws account id", validators: []validator.String{awsAccountIDValidator()}, value: types.StringValue("123456789012"), valid: true},
+		{name: "short aws account id", validators: []validator.String{awsAccountIDValidator()}, value: types.StringValue("12345678901"), valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		assert.Equal(t, tc.valid, validateString(tc.validators, tc.value))
+	}
+}
+
+func TestVlanValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.Int64
+		valid bool
+	}{
+		{name: "lowest vlan", value: types.Int64Value(1), valid: true},
+		{name: "highest vlan", value: types.Int64Value(4094), valid: true},
+		{name: "reserved vlan 0", value: types.Int64Value(0), valid: false},
+		{name: "reserved vlan 4095", value: types.Int64Value(4095), valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		resp := &validator.Int64Response{}
+		vlanValidator().ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root("vlan"), ConfigValue: tc.value}, resp)
+		assert.Equal(t, tc.valid, !resp.Diagnostics.HasError())
+	}
+}
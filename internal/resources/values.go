@@ -0,0 +1,18 @@
+package autonomiresource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalStringValue converts an API string of an optional attribute, the API returning an empty
+// string for unset values. An empty value is kept as an explicit empty string only if `prior` was
+// set as such, otherwise it is converted to null so unset attributes do not drift.
+func optionalStringValue(prior types.String, value string) types.String {
+	if value != "" {
+		return types.StringValue(value)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return types.StringNull()
+}
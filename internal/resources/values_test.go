@@ -0,0 +1,59 @@
+package autonomiresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalStringValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		prior  types.String
+		value  string
+		expect types.String
+	}{
+		{
+			name:   "value set",
+			prior:  types.StringNull(),
+			value:  "value",
+			expect: types.StringValue("value"),
+		},
+		{
+			name:   "value changed",
+			prior:  types.StringValue("prior"),
+			value:  "value",
+			expect: types.StringValue("value"),
+		},
+		{
+			name:   "unset value is null",
+			prior:  types.StringNull(),
+			value:  "",
+			expect: types.StringNull(),
+		},
+		{
+			name:   "unknown value is null",
+			prior:  types.StringUnknown(),
+			value:  "",
+			expect: types.StringNull(),
+		},
+		{
+			name:   "removed value is null",
+			prior:  types.StringValue("prior"),
+			value:  "",
+			expect: types.StringNull(),
+		},
+		{
+			name:   "explicit empty string is kept",
+			prior:  types.StringValue(""),
+			value:  "",
+			expect: types.StringValue(""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		assert.Equal(t, tc.expect, optionalStringValue(tc.prior, tc.value))
+	}
+}
@@ -4,48 +4,69 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
+	"github.com/meilisearch/meilisearch-go"
 )
 
 // virtualAccessNodeResource is the resource implementation.
 type virtualAccessNodeResource struct {
-	client *autonomisdk.Client
+	client  *autonomisdk.Client
+	catalog *meilisearch.Client
+	// enforceCommitmentTerms raises commitment term warnings as errors
+	enforceCommitmentTerms bool
+	budget                 *budget.Ledger
+	prices                 *budget.Prices
 }
 
 var serviceKey = map[string]attr.Type{
 	"id":              types.StringType,
 	"name":            types.StringType,
-	"expiration_date": types.StringType,
+	"expiration_date": timetypes.RFC3339Type{},
 }
 
 type virtualAccessNodeResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
-	DeployedAt  types.String `tfsdk:"deployed_at"`
-	Name        types.String `tfsdk:"name"`
-	State       types.String `tfsdk:"administrative_state"`
-	Type        types.String `tfsdk:"type"`
-	Product     product      `tfsdk:"product"`
-	Vlan        types.Int64  `tfsdk:"vlan"`
-	ServiceKey  types.Object `tfsdk:"service_key"`
+	ID                 types.String      `tfsdk:"id"`
+	WorkspaceID        types.String      `tfsdk:"workspace_id"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt          timetypes.RFC3339 `tfsdk:"updated_at"`
+	DeployedAt         timetypes.RFC3339 `tfsdk:"deployed_at"`
+	Name               types.String      `tfsdk:"name"`
+	State              types.String      `tfsdk:"administrative_state"`
+	Type               types.String      `tfsdk:"type"`
+	Product            product           `tfsdk:"product"`
+	Vlan               types.Int64       `tfsdk:"vlan"`
+	ServiceKey         types.Object      `tfsdk:"service_key"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &virtualAccessNodeResource{}
-	_ resource.ResourceWithConfigure = &virtualAccessNodeResource{}
+	_ resource.Resource                 = &virtualAccessNodeResource{}
+	_ resource.ResourceWithConfigure    = &virtualAccessNodeResource{}
+	_ resource.ResourceWithUpgradeState = &virtualAccessNodeResource{}
+	_ resource.ResourceWithModifyPlan   = &virtualAccessNodeResource{}
 )
 
+// virtualAccessNodeImmutablePaths lists the attributes that cannot be updated in place.
+var virtualAccessNodeImmutablePaths = []path.Path{
+	path.Root("workspace_id"),
+	path.Root("product").AtName("sku"),
+}
+
 // NewAccessNodeResource is a helper function to simplify the provider implementation.
 func NewVirtualAccessNodeResource() resource.Resource {
 	return &virtualAccessNodeResource{}
@@ -59,18 +80,22 @@ func (r *virtualAccessNodeResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.catalog = clients.CatalogClient
+	r.enforceCommitmentTerms = clients.EnforceCommitmentTerms
+	r.budget = clients.Budget
+	r.prices = clients.Prices
 }
 
 // Metadata returns the resource type name.
@@ -81,6 +106,7 @@ func (r *virtualAccessNodeResource) Metadata(_ context.Context, req resource.Met
 // Schema defines the schema for the resource.
 func (r *virtualAccessNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages a virtual access node resource.
 Virtual access node resource allows you to create, modify and delete Autonomi virtual access nodes.
 Autonomi virtual access node allows you to easily connect to your datacenters assets via a virtual connection through Megaport / Equinix connections (virtual access nodes).`,
@@ -93,39 +119,47 @@ Autonomi virtual access node allows you to easily connect to your datacenters as
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the access node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the access node",
 				Computed:            true,
 			},
 			"deployed_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Deployment date of the access node",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the workspace to which the access node belongs.",
 				Required:            true,
+				Validators: []validator.String{
+					uuidValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the access node",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"administrative_state": schema.StringAttribute{
 				MarkdownDescription: `Administrative state of the access node [creation_pending, creation_proceed, creation_error,
 deployed, delete_pending, delete_proceed, delete_error]`,
 				Computed: true,
-			},
-			"product": schema.SingleNestedAttribute{
-				Required: true,
-				Attributes: map[string]schema.Attribute{
-					"sku": schema.StringAttribute{
-						MarkdownDescription: "ID of the product",
-						Required:            true,
-					},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"product": productAttribute(),
 			"vlan": schema.Int64Attribute{
 				MarkdownDescription: "Vlan of the access node",
 				Computed:            true,
@@ -136,10 +170,16 @@ deployed, delete_pending, delete_proceed, delete_error]`,
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Type of the node [access]",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"service_key": schema.SingleNestedAttribute{
 				MarkdownDescription: "Access node's service key",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
 						MarkdownDescription: "ID of the service key",
@@ -156,6 +196,7 @@ deployed, delete_pending, delete_proceed, delete_error]`,
 						},
 					},
 					"expiration_date": schema.StringAttribute{
+						CustomType:          timetypes.RFC3339Type{},
 						MarkdownDescription: "expiration date of the service key",
 						Computed:            true,
 						PlanModifiers: []planmodifier.String{
@@ -164,10 +205,22 @@ deployed, delete_pending, delete_proceed, delete_error]`,
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("virtual access node"),
 		},
 	}
 }
 
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *virtualAccessNodeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at", "deployed_at", "service_key.expiration_date")
+			setDefault(rawState, "deletion_protection", false)
+		}),
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *virtualAccessNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -201,9 +254,10 @@ func (r *virtualAccessNodeResource) Create(ctx context.Context, req resource.Cre
 	plan.ID = types.StringValue(node.ID.String())
 	plan.State = types.StringValue(node.State.String())
 	plan.Type = types.StringValue(node.Type.String())
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
+	plan.CreatedAt = timestampValue(node.CreatedAt)
+	plan.UpdatedAt = timestampValue(node.UpdatedAt)
+	plan.DeployedAt = timestampValue(node.DeployedAt)
+	plan.Product = plannedProduct(plan.Product, node.Product)
 	plan.Vlan = types.Int64Value(node.Vlan)
 	// set serviceKey object
 	serviceKeyObject, diag := types.ObjectValue(
@@ -211,7 +265,7 @@ func (r *virtualAccessNodeResource) Create(ctx context.Context, req resource.Cre
 		map[string]attr.Value{
 			"id":              types.StringValue(node.ServiceKey.ID),
 			"name":            types.StringValue(node.ServiceKey.Name),
-			"expiration_date": types.StringValue(node.ServiceKey.ExpirationDate.String()),
+			"expiration_date": timestampValue(node.ServiceKey.ExpirationDate),
 		},
 	)
 	// Check for errors
@@ -250,22 +304,20 @@ func (r *virtualAccessNodeResource) Read(ctx context.Context, req resource.ReadR
 
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(node.ID.String())
-	state.CreatedAt = types.StringValue(node.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	state.DeployedAt = types.StringValue(node.DeployedAt.String())
+	state.CreatedAt = timestampValue(node.CreatedAt)
+	state.UpdatedAt = timestampValue(node.UpdatedAt)
+	state.DeployedAt = timestampValue(node.DeployedAt)
 	state.Name = types.StringValue(node.Name)
 	state.State = types.StringValue(node.State.String())
 	state.Type = types.StringValue(node.Type.String())
-	state.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
+	state.Product = productFromAPI(state.Product, node.Product)
 	state.Vlan = types.Int64Value(node.Vlan)
 	serviceKeyObject, diag := types.ObjectValue(
 		serviceKey,
 		map[string]attr.Value{
 			"id":              types.StringValue(node.ServiceKey.ID),
 			"name":            types.StringValue(node.ServiceKey.Name),
-			"expiration_date": types.StringValue(node.ServiceKey.ExpirationDate.String()),
+			"expiration_date": timestampValue(node.ServiceKey.ExpirationDate),
 		},
 	)
 	// Check for errors
@@ -311,16 +363,16 @@ func (r *virtualAccessNodeResource) Update(ctx context.Context, req resource.Upd
 	plan.ID = types.StringValue(node.ID.String())
 	plan.State = types.StringValue(node.State.String())
 	plan.Type = types.StringValue(node.Type.String())
-	plan.CreatedAt = types.StringValue(node.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(node.UpdatedAt.String())
-	plan.DeployedAt = types.StringValue(node.DeployedAt.String())
+	plan.CreatedAt = timestampValue(node.CreatedAt)
+	plan.UpdatedAt = timestampValue(node.UpdatedAt)
+	plan.DeployedAt = timestampValue(node.DeployedAt)
 	plan.Vlan = types.Int64Value(node.Vlan)
 	serviceKeyObject, diag := types.ObjectValue(
 		serviceKey,
 		map[string]attr.Value{
 			"id":              types.StringValue(node.ServiceKey.ID),
 			"name":            types.StringValue(node.ServiceKey.Name),
-			"expiration_date": types.StringValue(node.ServiceKey.ExpirationDate.String()),
+			"expiration_date": timestampValue(node.ServiceKey.ExpirationDate),
 		},
 	)
 	// Check for errors
@@ -329,9 +381,7 @@ func (r *virtualAccessNodeResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	plan.ServiceKey = serviceKeyObject
-	plan.Product = product{
-		SKU: types.StringValue(node.Product.SKU),
-	}
+	plan.Product = plannedProduct(plan.Product, node.Product)
 	plan.Vlan = types.Int64Value(node.Vlan)
 
 	diags = resp.State.Set(ctx, plan)
@@ -341,6 +391,31 @@ func (r *virtualAccessNodeResource) Update(ctx context.Context, req resource.Upd
 	}
 }
 
+// ModifyPlan prevents the destruction of a protected virtual access node, rejects the changes that cannot be made in place,
+// warns when its commitment term is not over, checks the product is a virtual access product, resolving its details,
+// and keeps its workspace within budget.
+func (r *virtualAccessNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "virtual access node")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkImmutable(ctx, req, resp, "virtual access node", virtualAccessNodeImmutablePaths...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkCommitmentTerm(ctx, req, resp, "virtual access node", r.enforceCommitmentTerms, path.Root("deployed_at"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkProductSKU(ctx, r.catalog, virtualAccessProductIndex, req, resp, nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWorkspaceBudget(ctx, r.client, r.budget, r.prices, req, resp, "virtual access node")
+}
+
 func (r *virtualAccessNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state virtualAccessNodeResourceModel
@@ -350,6 +425,11 @@ func (r *virtualAccessNodeResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(deletionProtectionError("virtual access node", state.ID.ValueString()))
+		return
+	}
+
 	// Delete existing node
 	_, err := r.client.DeleteNode(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString(), autonomisdk.WithWaitUntilElementUndeployed())
 	if err != nil {
@@ -4,20 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	autonomisdk "github.com/intercloud/autonomi-sdk"
 	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/intercloud/terraform-provider-autonomi/internal/budget"
+	"github.com/intercloud/terraform-provider-autonomi/internal/providerdata"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &workspaceResource{}
-	_ resource.ResourceWithConfigure = &workspaceResource{}
+	_ resource.Resource                 = &workspaceResource{}
+	_ resource.ResourceWithConfigure    = &workspaceResource{}
+	_ resource.ResourceWithUpgradeState = &workspaceResource{}
+	_ resource.ResourceWithModifyPlan   = &workspaceResource{}
 )
 
 // NewWorkspaceResource is a helper function to simplify the provider implementation.
@@ -28,15 +36,28 @@ func NewWorkspaceResource() resource.Resource {
 // workspaceResource is the resource implementation.
 type workspaceResource struct {
 	client *autonomisdk.Client
+	budget *budget.Ledger
+	prices *budget.Prices
 }
 
 type workspaceResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	AccountID   types.String `tfsdk:"account_id"`
+	ID             types.String      `tfsdk:"id"`
+	CreatedAt      timetypes.RFC3339 `tfsdk:"created_at"`
+	UpdatedAt      timetypes.RFC3339 `tfsdk:"updated_at"`
+	Name           types.String      `tfsdk:"name"`
+	Description    types.String      `tfsdk:"description"`
+	AccountID      types.String      `tfsdk:"account_id"`
+	MaxMonthlyCost types.Int64       `tfsdk:"max_monthly_cost"`
+}
+
+// fromWorkspace maps the API workspace onto the model, keeping an unset description null.
+func (m *workspaceResourceModel) fromWorkspace(workspace *models.Workspace) {
+	m.ID = types.StringValue(workspace.ID.String())
+	m.CreatedAt = timestampValue(workspace.CreatedAt)
+	m.UpdatedAt = timestampValue(workspace.UpdatedAt)
+	m.Name = types.StringValue(workspace.Name)
+	m.Description = optionalStringValue(m.Description, workspace.Description)
+	m.AccountID = types.StringValue(workspace.AccountID)
 }
 
 // Metadata returns the resource type name.
@@ -52,23 +73,26 @@ func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*autonomisdk.Client)
+	clients, ok := req.ProviderData.(providerdata.Clients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *autonomi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.AutonomiClient
+	r.budget = clients.Budget
+	r.prices = clients.Prices
 }
 
 // Schema defines the schema for the resource.
 func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages a workspace resource.
 Workspace resource allows you to create, modify and delete Autonomi workspaces.
 Autonomi workspaces allows you to easily organize your projects by grouping Autonomi elements together.`,
@@ -81,16 +105,22 @@ Autonomi workspaces allows you to easily organize your projects by grouping Auto
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Creation date of the workspace",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "Update date of the workspace",
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the workspace",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the workspace",
@@ -103,10 +133,51 @@ Autonomi workspaces allows you to easily organize your projects by grouping Auto
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"max_monthly_cost": schema.Int64Attribute{
+				MarkdownDescription: `Maximum monthly cost of the workspace, defaulting to the ` + "`max_monthly_cost`" + ` of the provider.
+Plans pushing the monthly recurring price of the nodes and transports of the workspace over it fail,
+with a breakdown by element. The elements existing in the workspace are counted along with the planned
+ones, without the elements planned for destruction, and the elements of a workspace not created yet are
+not checked.`,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
 
+// UpgradeState upgrades the prior states to the current schema version.
+func (r *workspaceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored timestamps formatted by Go, zero timestamps included
+		0: rawStateUpgrader(func(rawState map[string]any) {
+			upgradeTimestamps(rawState, "created_at", "updated_at")
+		}),
+	}
+}
+
+// ModifyPlan records the budget of the workspace, raising an error when its existing and planned elements
+// exceed it.
+func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.budget == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan workspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ID.IsUnknown() || plan.MaxMonthlyCost.IsUnknown() {
+		return
+	}
+
+	seedWorkspaceBudget(ctx, r.client, r.budget, r.prices, resp, plan.ID.ValueString())
+	r.budget.SetBudget(plan.ID.ValueString(), plan.MaxMonthlyCost.ValueInt64Pointer())
+	if summary, detail, exceeded := workspaceBudgetExceeded(r.budget, plan.ID.ValueString()); exceeded {
+		resp.Diagnostics.AddAttributeError(path.Root("max_monthly_cost"), summary, detail)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -134,10 +205,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(workspace.ID.String())
-	plan.CreatedAt = types.StringValue(workspace.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(workspace.UpdatedAt.String())
-	plan.AccountID = types.StringValue(workspace.AccountID)
+	plan.fromWorkspace(workspace)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -168,12 +236,7 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Overwrite items with refreshed state
-	state.ID = types.StringValue(workspace.ID.String())
-	state.Name = types.StringValue(workspace.Name)
-	state.Description = types.StringValue(workspace.Description)
-	state.CreatedAt = types.StringValue(workspace.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(workspace.UpdatedAt.String())
-	state.AccountID = types.StringValue(workspace.AccountID)
+	state.fromWorkspace(workspace)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -210,11 +273,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Update resource state with updated items and timestamp
-	plan.ID = types.StringValue(workspace.ID.String())
-	plan.Name = types.StringValue(workspace.Name)
-	plan.Description = types.StringValue(workspace.Description)
-	plan.CreatedAt = types.StringValue(workspace.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(workspace.UpdatedAt.String())
+	plan.fromWorkspace(workspace)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,54 @@
+package autonomiresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/intercloud/autonomi-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceFromWorkspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		prior       types.String
+		description string
+		expect      types.String
+	}{
+		{
+			name:        "description set",
+			prior:       types.StringValue("description"),
+			description: "description",
+			expect:      types.StringValue("description"),
+		},
+		{
+			name:        "description unset",
+			prior:       types.StringNull(),
+			description: "",
+			expect:      types.StringNull(),
+		},
+		{
+			name:        "description set to an empty string",
+			prior:       types.StringValue(""),
+			description: "",
+			expect:      types.StringValue(""),
+		},
+		{
+			name:        "description changed outside of terraform",
+			prior:       types.StringNull(),
+			description: "description",
+			expect:      types.StringValue("description"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		workspace := &models.Workspace{Name: "workspace", Description: tc.description}
+		model := workspaceResourceModel{Description: tc.prior}
+		model.fromWorkspace(workspace)
+
+		assert.Equal(t, tc.expect, model.Description)
+		assert.Equal(t, "workspace", model.Name.ValueString())
+	}
+}
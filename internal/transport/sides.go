@@ -0,0 +1,127 @@
+// Package transport keeps track of the sides of the transports attached to nodes while Terraform plans
+// the attachments, so conflicting attachments are refused at plan time.
+package transport
+
+import (
+	"errors"
+	"sync"
+)
+
+// sides is the number of sides of a transport, A and Z.
+const sides = 2
+
+var (
+	// ErrNodeAttached is returned when the node is already attached to the transport.
+	ErrNodeAttached = errors.New("node already attached")
+	// ErrSideAttached is returned when the side of the transport is already attached.
+	ErrSideAttached = errors.New("side already attached")
+	// ErrNoFreeSide is returned when both sides of the transport are already attached.
+	ErrNoFreeSide = errors.New("no free side")
+)
+
+// Attachment is an attachment of a node to a side of a transport. The node or the side are empty when
+// not known at plan time.
+type Attachment struct {
+	// ID of the attachment, empty when planned for creation
+	ID     string
+	NodeID string
+	Side   string
+}
+
+// Sides records the attachments of the transports during a Terraform run, the attachments existing in a
+// workspace being seeded from the API before the planned ones are recorded. It is shared by the resources
+// through the provider data.
+type Sides struct {
+	mu          sync.Mutex
+	attachments map[string][]Attachment
+	seeded      map[string]bool
+}
+
+// NewSides returns a record without any attachment.
+func NewSides() *Sides {
+	return &Sides{
+		attachments: map[string][]Attachment{},
+		seeded:      map[string]bool{},
+	}
+}
+
+// Seed records the attachments existing in the workspace, as listed by `list` by transport ID, the first
+// time it is called for the workspace. The workspace is seeded again on the next call when listing its
+// attachments fails.
+func (s *Sides) Seed(workspaceID string, list func() (map[string][]Attachment, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seeded[workspaceID] {
+		return nil
+	}
+	attachments, err := list()
+	if err != nil {
+		return err
+	}
+
+	s.seeded[workspaceID] = true
+	for transportID, existing := range attachments {
+		for _, attachment := range existing {
+			// attachments recorded before the seeding were planned against their current state
+			if s.index(transportID, attachment.ID) < 0 {
+				s.attachments[transportID] = append(s.attachments[transportID], attachment)
+			}
+		}
+	}
+	return nil
+}
+
+// Detach forgets the attachment of the transport, e.g. when planned to be replaced or destroyed, so its
+// side is free for the other attachments.
+func (s *Sides) Detach(transportID, attachmentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.index(transportID, attachmentID); i >= 0 {
+		s.attachments[transportID] = append(s.attachments[transportID][:i:i], s.attachments[transportID][i+1:]...)
+	}
+}
+
+// index returns the index of the attachment among the ones of the transport, -1 when it is not recorded
+// or planned for creation.
+func (s *Sides) index(transportID, attachmentID string) int {
+	if attachmentID == "" {
+		return -1
+	}
+	for i, attachment := range s.attachments[transportID] {
+		if attachment.ID == attachmentID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Attach records the attachment of the transport, replacing the one recorded with the same ID, and returns
+// an error when the node is already attached to the transport, or when the side of the transport is not
+// free. The attachment is not recorded then.
+func (s *Sides) Attach(transportID string, attachment Attachment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	others := s.attachments[transportID]
+	if i := s.index(transportID, attachment.ID); i >= 0 {
+		others = append(others[:i:i], others[i+1:]...)
+	}
+	for _, other := range others {
+		if attachment.NodeID != "" && other.NodeID == attachment.NodeID {
+			return ErrNodeAttached
+		}
+	}
+	if len(others) >= sides {
+		return ErrNoFreeSide
+	}
+	for _, other := range others {
+		if attachment.Side != "" && other.Side == attachment.Side {
+			return ErrSideAttached
+		}
+	}
+
+	s.attachments[transportID] = append(others, attachment)
+	return nil
+}
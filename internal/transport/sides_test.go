@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSidesAttach(t *testing.T) {
+	tests := []struct {
+		name       string
+		attached   []Attachment
+		attachment Attachment
+		err        error
+	}{
+		{
+			name:       "free transport",
+			attachment: Attachment{NodeID: "node-a"},
+		},
+		{
+			name:       "free side",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}},
+			attachment: Attachment{NodeID: "node-z", Side: "Z"},
+		},
+		{
+			name:       "side left to the platform",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}},
+			attachment: Attachment{NodeID: "node-z"},
+		},
+		{
+			name:       "node created along with the attachment",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}},
+			attachment: Attachment{Side: "Z"},
+		},
+		{
+			name:       "node already attached",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}},
+			attachment: Attachment{NodeID: "node-a", Side: "Z"},
+			err:        ErrNodeAttached,
+		},
+		{
+			name:       "side already attached",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}},
+			attachment: Attachment{NodeID: "node-z", Side: "A"},
+			err:        ErrSideAttached,
+		},
+		{
+			name:       "both sides already attached",
+			attached:   []Attachment{{NodeID: "node-a", Side: "A"}, {NodeID: "node-z"}},
+			attachment: Attachment{NodeID: "node-b"},
+			err:        ErrNoFreeSide,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+
+		sides := NewSides()
+		for _, attachment := range tc.attached {
+			assert.NoError(t, sides.Attach("transport", attachment))
+		}
+
+		assert.Equal(t, tc.err, sides.Attach("transport", tc.attachment))
+	}
+}
+
+func TestSidesSeed(t *testing.T) {
+	sides := NewSides()
+
+	// listing errors are not remembered
+	err := sides.Seed("workspace", func() (map[string][]Attachment, error) { return nil, assert.AnError })
+	assert.Equal(t, assert.AnError, err)
+
+	// attachments recorded before the seeding prevail
+	assert.NoError(t, sides.Attach("transport", Attachment{ID: "attachment-a", NodeID: "node-a", Side: "Z"}))
+	assert.NoError(t, sides.Seed("workspace", func() (map[string][]Attachment, error) {
+		return map[string][]Attachment{
+			"transport": {{ID: "attachment-a", NodeID: "node-a", Side: "A"}},
+		}, nil
+	}))
+	assert.NoError(t, sides.Seed("workspace", func() (map[string][]Attachment, error) {
+		t.Error("workspace seeded twice")
+		return nil, nil
+	}))
+
+	assert.Equal(t, ErrSideAttached, sides.Attach("transport", Attachment{NodeID: "node-z", Side: "Z"}))
+	// an attachment planned again does not conflict with itself
+	assert.NoError(t, sides.Attach("transport", Attachment{ID: "attachment-a", NodeID: "node-a", Side: "A"}))
+	assert.NoError(t, sides.Attach("transport", Attachment{NodeID: "node-z", Side: "Z"}))
+	assert.Equal(t, ErrNoFreeSide, sides.Attach("transport", Attachment{NodeID: "node-b"}))
+
+	// the side of a destroyed attachment is free
+	sides.Detach("transport", "attachment-a")
+	assert.NoError(t, sides.Attach("transport", Attachment{NodeID: "node-b", Side: "A"}))
+}